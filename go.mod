@@ -6,3 +6,5 @@ require (
 	github.com/hashicorp/terraform-plugin-sdk/v2 v2.7.0
 	github.com/rmalveis/report-portal-client-go v0.1.5
 )
+
+replace github.com/rmalveis/report-portal-client-go => ./report-portal-client-go
@@ -0,0 +1,287 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	rpClient "github.com/rmalveis/report-portal-client-go/client"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// The tests below exercise the vendored client against the fake ReportPortal, without Terraform.
+
+func testClient(t *testing.T, fake *fakeReportPortal) *rpClient.Client {
+	c, err := rpClient.NewClient(&rpClient.ReportPortalClientConfig{
+		Host:     fake.URL(),
+		Username: fakeUsername,
+		Password: fakePassword,
+	}, &http.Client{})
+	if err != nil {
+		t.Fatalf("unable to create the client: %s", err)
+	}
+	return c
+}
+
+func TestClient_refreshesRejectedToken(t *testing.T) {
+	fake := newFakeReportPortal(t)
+	c := testClient(t, fake)
+
+	fake.revokeAccessToken()
+
+	// the retried request must be sent with its body
+	name := "tf_client_refresh"
+	if _, err := c.CreateProject(&name); err != nil {
+		t.Fatalf("request after token rejection failed: %s", err)
+	}
+	if !fake.hasProject(name) {
+		t.Fatalf("project %s was not created by the retried request", name)
+	}
+
+	grants, refreshGrants, unauthorized := fake.tokenStats()
+	if grants != 2 || refreshGrants != 1 || unauthorized != 1 {
+		t.Errorf("expected 2 token grants, 1 refresh and 1 rejected request, got %d, %d and %d", grants, refreshGrants, unauthorized)
+	}
+}
+
+func TestClient_authenticatesAgainWhenRefreshFails(t *testing.T) {
+	fake := newFakeReportPortal(t)
+	fake.configureTokens(3600, true)
+	c := testClient(t, fake)
+
+	fake.revokeAccessToken()
+
+	if _, err := c.GetAllProjects(); err != nil {
+		t.Fatalf("request after token rejection failed: %s", err)
+	}
+
+	grants, refreshGrants, unauthorized := fake.tokenStats()
+	if grants != 2 || refreshGrants != 1 || unauthorized != 1 {
+		t.Errorf("expected 2 password grants after 1 refused refresh and 1 rejected request, got %d, %d and %d", grants, refreshGrants, unauthorized)
+	}
+}
+
+func TestClient_renewsExpiringToken(t *testing.T) {
+	fake := newFakeReportPortal(t)
+	// shorter than the expiration margin, so the token is renewed before every request
+	fake.configureTokens(10, false)
+	c := testClient(t, fake)
+
+	if _, err := c.GetAllProjects(); err != nil {
+		t.Fatalf("request with an expiring token failed: %s", err)
+	}
+
+	grants, refreshGrants, unauthorized := fake.tokenStats()
+	if grants != 2 || refreshGrants != 1 || unauthorized != 0 {
+		t.Errorf("expected the token to be refreshed once before the request, got %d grants, %d refreshes and %d rejected requests", grants, refreshGrants, unauthorized)
+	}
+}
+
+func TestClient_failsWithRejectedCredentials(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	_, err := rpClient.NewClient(&rpClient.ReportPortalClientConfig{
+		Host:     fake.URL(),
+		Username: fakeUsername,
+		Password: "wrong",
+	}, &http.Client{})
+	if err == nil {
+		t.Fatal("expected the client creation to fail with wrong credentials")
+	}
+}
+
+// testRetryServer answers with the given status codes in order, then with 200, recording the requests.
+func testRetryServer(t *testing.T, header http.Header, statusCodes ...int) (*httptest.Server, *int32) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		n := int(atomic.AddInt32(&requests, 1))
+		if n <= len(statusCodes) {
+			for key, values := range header {
+				w.Header()[key] = values
+			}
+			writeFakeError(w, statusCodes[n-1], 50000, "transient failure")
+			return
+		}
+		writeFakeJson(w, http.StatusOK, map[string]interface{}{"id": 1, "content": []interface{}{}})
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &requests
+}
+
+func testRetryClient(t *testing.T, server *httptest.Server, maxRetries int, waitMin, waitMax time.Duration) *rpClient.Client {
+	c, err := rpClient.NewClient(&rpClient.ReportPortalClientConfig{
+		Host:         server.URL,
+		ApiToken:     "token",
+		MaxRetries:   maxRetries,
+		RetryWaitMin: waitMin,
+		RetryWaitMax: waitMax,
+	}, &http.Client{})
+	if err != nil {
+		t.Fatalf("unable to create the client: %s", err)
+	}
+	return c
+}
+
+func TestClient_retries(t *testing.T) {
+	cases := []struct {
+		name         string
+		statusCodes  []int
+		post         bool
+		wantRequests int32
+		wantError    bool
+	}{
+		{"429 is retried", []int{http.StatusTooManyRequests}, false, 2, false},
+		{"5xx is retried", []int{http.StatusBadGateway, http.StatusServiceUnavailable}, false, 3, false},
+		{"retries are bounded", []int{500, 500, 500, 500}, false, 3, true},
+		{"4xx is not retried", []int{http.StatusBadRequest}, false, 1, true},
+		{"POST is not retried on 5xx", []int{http.StatusBadGateway}, true, 1, true},
+		{"POST is not retried on 429", []int{http.StatusTooManyRequests}, true, 1, true},
+	}
+
+	for _, c := range cases {
+		server, requests := testRetryServer(t, nil, c.statusCodes...)
+		client := testRetryClient(t, server, 2, time.Millisecond, 5*time.Millisecond)
+
+		var err error
+		if c.post {
+			name := "tf_retry"
+			_, err = client.CreateProject(&name)
+		} else {
+			_, err = client.GetAllProjects()
+		}
+
+		if (err != nil) != c.wantError {
+			t.Errorf("%s: expected error %t, got %v", c.name, c.wantError, err)
+		}
+		if got := atomic.LoadInt32(requests); got != c.wantRequests {
+			t.Errorf("%s: expected %d requests, got %d", c.name, c.wantRequests, got)
+		}
+	}
+}
+
+func TestClient_honoursRetryAfter(t *testing.T) {
+	server, requests := testRetryServer(t, http.Header{"Retry-After": []string{"1"}}, http.StatusTooManyRequests)
+	client := testRetryClient(t, server, 1, time.Millisecond, 5*time.Second)
+
+	start := time.Now()
+	if _, err := client.GetAllProjects(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("expected the client to wait for the Retry-After delay of 1s, retried after %s", elapsed)
+	}
+	if got := atomic.LoadInt32(requests); got != 2 {
+		t.Errorf("expected 2 requests, got %d", got)
+	}
+}
+
+func TestClient_boundsRetryAfter(t *testing.T) {
+	server, _ := testRetryServer(t, http.Header{"Retry-After": []string{"3600"}}, http.StatusServiceUnavailable)
+	client := testRetryClient(t, server, 1, time.Millisecond, 10*time.Millisecond)
+
+	start := time.Now()
+	if _, err := client.GetAllProjects(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected the Retry-After delay to be bounded by the maximum wait, retried after %s", elapsed)
+	}
+}
+
+func TestClient_apiErrors(t *testing.T) {
+	cases := []struct {
+		name          string
+		statusCode    int
+		body          string
+		wantErrorCode int
+		wantMessage   string
+		wantError     string
+	}{
+		{
+			"ReportPortal error", http.StatusNotFound,
+			`{"errorCode": 40422, "message": "Project 'missing' not found."}`,
+			40422, "Project 'missing' not found.",
+			"ReportPortal API error (status: 404, errorCode: 40422): Project 'missing' not found.",
+		},
+		{
+			"OAuth error description", http.StatusBadRequest,
+			`{"error": "invalid_grant", "error_description": "Bad credentials"}`,
+			0, "Bad credentials",
+			"ReportPortal API error (status: 400): Bad credentials",
+		},
+		{
+			"OAuth error without description", http.StatusUnauthorized,
+			`{"error": "unauthorized"}`,
+			0, "unauthorized",
+			"ReportPortal API error (status: 401): unauthorized",
+		},
+		{
+			"non JSON body", http.StatusBadGateway,
+			`<html>Bad Gateway</html>`,
+			0, "",
+			"ReportPortal API error (status: 502): <html>Bad Gateway</html>",
+		},
+	}
+
+	for _, c := range cases {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(c.statusCode)
+			_, _ = w.Write([]byte(c.body))
+		}))
+		client := testRetryClient(t, server, 0, time.Millisecond, time.Millisecond)
+
+		_, err := client.GetAllProjects()
+		server.Close()
+
+		apiErr, ok := err.(*rpClient.APIError)
+		if !ok {
+			t.Errorf("%s: expected an APIError, got %T: %v", c.name, err, err)
+			continue
+		}
+		if apiErr.StatusCode != c.statusCode || apiErr.ErrorCode != c.wantErrorCode || apiErr.Message != c.wantMessage {
+			t.Errorf("%s: got status %d, errorCode %d and message %q", c.name, apiErr.StatusCode, apiErr.ErrorCode, apiErr.Message)
+		}
+		if apiErr.Body != c.body {
+			t.Errorf("%s: expected the raw body to be kept, got %q", c.name, apiErr.Body)
+		}
+		if apiErr.Error() != c.wantError {
+			t.Errorf("%s: got error %q, expected %q", c.name, apiErr.Error(), c.wantError)
+		}
+	}
+}
+
+func TestClient_apiErrorStatus(t *testing.T) {
+	cases := []struct {
+		err                                         error
+		notFound, conflict, unauthorized, forbidden bool
+	}{
+		{&rpClient.APIError{StatusCode: http.StatusNotFound}, true, false, false, false},
+		{&rpClient.APIError{StatusCode: http.StatusConflict}, false, true, false, false},
+		{&rpClient.APIError{StatusCode: http.StatusUnauthorized}, false, false, true, false},
+		{&rpClient.APIError{StatusCode: http.StatusForbidden}, false, false, false, true},
+		{fmt.Errorf("reading project: %w", &rpClient.APIError{StatusCode: http.StatusNotFound}), true, false, false, false},
+		{&rpClient.APIError{StatusCode: http.StatusInternalServerError}, false, false, false, false},
+		{errors.New("connection refused"), false, false, false, false},
+		{nil, false, false, false, false},
+	}
+
+	for _, c := range cases {
+		if got := rpClient.IsNotFound(c.err); got != c.notFound {
+			t.Errorf("IsNotFound(%v) = %t", c.err, got)
+		}
+		if got := rpClient.IsConflict(c.err); got != c.conflict {
+			t.Errorf("IsConflict(%v) = %t", c.err, got)
+		}
+		if got := rpClient.IsUnauthorized(c.err); got != c.unauthorized {
+			t.Errorf("IsUnauthorized(%v) = %t", c.err, got)
+		}
+		if got := rpClient.IsForbidden(c.err); got != c.forbidden {
+			t.Errorf("IsForbidden(%v) = %t", c.err, got)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	rpClient "github.com/rmalveis/report-portal-client-go/client"
+	"strconv"
+	"time"
+)
+
+func dataSourceAuthActiveDirectorySettings() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceActiveDirectorySettingsRead,
+		Schema: map[string]*schema.Schema{
+			"auth_active_directory_settings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"domain": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ldap_attrs_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"ldap_attrs_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ldap_attrs_base_dn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ldap_attrs_sync_email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ldap_attrs_sync_fullname": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ldap_attrs_sync_photo": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceActiveDirectorySettingsRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	var diags diag.Diagnostics
+
+	rawSettings, err := c.ReadActiveDirectoryAuthSettings()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	settings := flattenActiveDirectorySettings(rawSettings)
+	settings["id"] = rawSettings.Id
+	state := append(make([]map[string]interface{}, 0), settings)
+
+	if err = data.Set("auth_active_directory_settings", state); err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
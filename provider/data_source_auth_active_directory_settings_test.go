@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceAuthActiveDirectorySettings_basic(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAuthActiveDirectorySettingsConfig(fake, "dc=example,dc=com") + `
+data "reportportal_auth_active_directory_settings" "test" {
+  depends_on = [reportportal_auth_active_directory_settings.test]
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.reportportal_auth_active_directory_settings.test", "auth_active_directory_settings.#", "1"),
+					resource.TestCheckResourceAttrPair("data.reportportal_auth_active_directory_settings.test", "auth_active_directory_settings.0.id", "reportportal_auth_active_directory_settings.test", "id"),
+					resource.TestCheckResourceAttr("data.reportportal_auth_active_directory_settings.test", "auth_active_directory_settings.0.domain", "example.com"),
+				),
+			},
+		},
+	})
+}
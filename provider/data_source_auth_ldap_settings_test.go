@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceAuthLdapSettings_basic(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAuthLdapSettingsConfig(fake, "(member={0})") + `
+data "reportportal_auth_ldap_settings" "test" {
+  depends_on = [reportportal_auth_ldap_settings.test]
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.reportportal_auth_ldap_settings.test", "auth_ldap_settings.#", "1"),
+					resource.TestCheckResourceAttrPair("data.reportportal_auth_ldap_settings.test", "auth_ldap_settings.0.id", "reportportal_auth_ldap_settings.test", "id"),
+					resource.TestCheckResourceAttr("data.reportportal_auth_ldap_settings.test", "auth_ldap_settings.0.ldap_attrs_base_dn", "dc=example,dc=com"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	rpClient "github.com/rmalveis/report-portal-client-go/client"
+	"strconv"
+	"time"
+)
+
+func dataSourceAuthSamlProviders() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAuthSamlProvidersRead,
+		Schema: map[string]*schema.Schema{
+			"providers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"metadata_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"name_attribute": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email_attribute": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"first_name_attribute": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_name_attribute": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"full_name_attribute": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"provider_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAuthSamlProvidersRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	var diags diag.Diagnostics
+
+	providers, err := c.GetAuthSamlProviders()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	providerSlice := make([]map[string]interface{}, 0, len(providers.Providers))
+	for idx := range providers.Providers {
+		provider := flattenSamlProvider(&providers.Providers[idx])
+		provider["id"] = providers.Providers[idx].Id
+		providerSlice = append(providerSlice, provider)
+	}
+
+	if err = data.Set("providers", providerSlice); err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceAuthSamlProviders_basic(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAuthSamlProviderConfig(fake, true, "mail") + `
+data "reportportal_auth_saml_providers" "test" {
+  depends_on = [reportportal_auth_saml_provider.test]
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.reportportal_auth_saml_providers.test", "providers.#", "1"),
+					resource.TestCheckResourceAttrPair("data.reportportal_auth_saml_providers.test", "providers.0.id", "reportportal_auth_saml_provider.test", "id"),
+					resource.TestCheckResourceAttr("data.reportportal_auth_saml_providers.test", "providers.0.name", "okta"),
+					resource.TestCheckResourceAttr("data.reportportal_auth_saml_providers.test", "providers.0.last_name_attribute", "sn"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceFilters_basic(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceFilterConfig(fake, "Nightly regression", "regression") + `
+data "reportportal_filters" "test" {
+  project_name = reportportal_filter.test.project_name
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.reportportal_filters.test", "filters.#", "1"),
+					resource.TestCheckResourceAttrPair("data.reportportal_filters.test", "filters.0.id", "reportportal_filter.test", "id"),
+					resource.TestCheckResourceAttr("data.reportportal_filters.test", "filters.0.name", "tf_acc_filter"),
+					resource.TestCheckResourceAttr("data.reportportal_filters.test", "filters.0.conditions.0.value", "regression"),
+				),
+			},
+		},
+	})
+}
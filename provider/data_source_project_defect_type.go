@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	rpClient "github.com/rmalveis/report-portal-client-go/client"
+	"sort"
+)
+
+func dataSourceProjectDefectTypes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceProjectDefectTypesRead,
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"defect_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"locator": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type_group": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"long_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"short_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"color": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"content_field": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceProjectDefectTypesRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	var diags diag.Diagnostics
+
+	projectName := data.Get("project_name").(string)
+
+	settings, err := c.GetProjectSettings(projectName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := data.Set("defect_types", mapIssueSubTypes(settings)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(projectName)
+
+	return diags
+}
+
+// mapIssueSubTypes flattens the sub-types of every group, following the group order used by ReportPortal.
+func mapIssueSubTypes(settings *rpClient.ProjectSettings) []map[string]interface{} {
+	subTypeSlice := make([]map[string]interface{}, 0, 10)
+	for _, group := range rpClient.IssueTypeGroups {
+		subTypes := settings.SubTypes[group]
+		sort.Slice(subTypes, func(a, b int) bool { return subTypes[a].Id < subTypes[b].Id })
+
+		for _, subType := range subTypes {
+			subTypeMap := make(map[string]interface{})
+			subTypeMap["id"] = subType.Id
+			subTypeMap["locator"] = subType.Locator
+			subTypeMap["type_group"] = subType.TypeRef
+			subTypeMap["long_name"] = subType.LongName
+			subTypeMap["short_name"] = subType.ShortName
+			subTypeMap["color"] = subType.Color
+			subTypeMap["content_field"] = subType.ContentField()
+			subTypeSlice = append(subTypeSlice, subTypeMap)
+		}
+	}
+	return subTypeSlice
+}
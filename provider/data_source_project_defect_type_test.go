@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceProjectDefectTypes_basic(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceProjectDefectTypeConfig(fake, "Environment Issue", "EI", "#0274d1") + `
+data "reportportal_project_defect_types" "test" {
+  project_name = reportportal_project_defect_type.test.project_name
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.reportportal_project_defect_types.test", "defect_types.#", "6"),
+					resource.TestCheckResourceAttr("data.reportportal_project_defect_types.test", "defect_types.0.locator", "pb001"),
+					resource.TestCheckResourceAttr("data.reportportal_project_defect_types.test", "defect_types.2.locator", "si001"),
+					resource.TestCheckResourceAttrPair("data.reportportal_project_defect_types.test", "defect_types.3.id", "reportportal_project_defect_type.test", "id"),
+					resource.TestCheckResourceAttrPair("data.reportportal_project_defect_types.test", "defect_types.3.locator", "reportportal_project_defect_type.test", "locator"),
+					resource.TestCheckResourceAttr("data.reportportal_project_defect_types.test", "defect_types.3.long_name", "Environment Issue"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceProjects_basic(t *testing.T) {
+	fake := newFakeReportPortal(t)
+	fake.addProject("tf_acc_existing")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(fake) + `
+data "reportportal_projects" "all" {}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.reportportal_projects.all", "projects.#", "1"),
+					resource.TestCheckResourceAttr("data.reportportal_projects.all", "projects.0.project_name", "tf_acc_existing"),
+					resource.TestCheckResourceAttr("data.reportportal_projects.all", "projects.0.entry_type", "INTERNAL"),
+				),
+			},
+		},
+	})
+}
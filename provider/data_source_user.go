@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	rpClient "github.com/rmalveis/report-portal-client-go/client"
+	"strconv"
+	"time"
+)
+
+func dataSourceUsers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceUsersRead,
+		Schema: map[string]*schema.Schema{
+			"login": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"login": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"full_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"account_role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"account_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUsersRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	var diags diag.Diagnostics
+
+	userQuery := rpClient.UserQuery{}
+	if login, ok := data.GetOk("login"); ok {
+		l := login.(string)
+		userQuery.Login = &l
+	}
+	if email, ok := data.GetOk("email"); ok {
+		e := email.(string)
+		userQuery.Email = &e
+	}
+
+	userSlice := make([]map[string]interface{}, 0, 10)
+	currentPage := 1
+	defaultSize := 100
+	for {
+		users, err := c.GetUsers(&userQuery, &rpClient.PaginationQuery{
+			Page: &currentPage,
+			Size: &defaultSize,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, user := range users.Content {
+			userMap := make(map[string]interface{})
+			userMap["id"] = user.Id
+			userMap["login"] = user.UserId
+			userMap["email"] = user.Email
+			userMap["full_name"] = user.FullName
+			userMap["account_role"] = user.UserRole
+			userMap["account_type"] = user.AccountType
+			userSlice = append(userSlice, userMap)
+		}
+
+		if currentPage >= users.Page.TotalPages {
+			break
+		}
+		currentPage++
+	}
+
+	if err := data.Set("users", userSlice); err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	return diags
+}
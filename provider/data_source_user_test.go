@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceUsers_basic(t *testing.T) {
+	fake := newFakeReportPortal(t)
+	fake.addUser("jdoe")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUserConfig(fake, "Test Runner", "USER") + `
+data "reportportal_users" "by_login" {
+  login = reportportal_user.test.login
+}
+
+data "reportportal_users" "by_email" {
+  email = "@example.com"
+
+  depends_on = [reportportal_user.test]
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.reportportal_users.by_login", "users.#", "1"),
+					resource.TestCheckResourceAttrPair("data.reportportal_users.by_login", "users.0.id", "reportportal_user.test", "id"),
+					resource.TestCheckResourceAttr("data.reportportal_users.by_login", "users.0.email", "runner@example.com"),
+					resource.TestCheckResourceAttr("data.reportportal_users.by_login", "users.0.full_name", "Test Runner"),
+					resource.TestCheckResourceAttr("data.reportportal_users.by_email", "users.#", "2"),
+					resource.TestCheckResourceAttr("data.reportportal_users.by_email", "users.0.login", "jdoe"),
+					resource.TestCheckResourceAttr("data.reportportal_users.by_email", "users.1.login", "tf_acc_runner"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"testing"
+)
+
+func TestAccDataSourceWidgetsByProjectAndId_basic(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceWidgetConfig(fake, "Overall", `["Total"]`) + `
+data "reportportal_widget_by_project_details" "test" {
+  project_name = reportportal_widget.test.project_name
+  id           = reportportal_widget.test.id
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.reportportal_widget_by_project_details.test", "widget_details.#", "1"),
+					resource.TestCheckResourceAttr("data.reportportal_widget_by_project_details.test", "widget_details.0.name", "Overall"),
+					resource.TestCheckResourceAttr("data.reportportal_widget_by_project_details.test", "widget_details.0.widget_type", "overallStatistics"),
+					resource.TestCheckResourceAttrPair("data.reportportal_widget_by_project_details.test", "widget_details.0.applied_filters.0.applied_filter_id", "reportportal_filter.test", "id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,1870 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	rpClient "github.com/rmalveis/report-portal-client-go/client"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const (
+	fakeUsername    = "superadmin"
+	fakePassword    = "erebus"
+	fakeAccessToken = "fake-access-token"
+	fakeApiToken    = "fake-api-token"
+)
+
+// fakeReportPortal is an in-memory ReportPortal implementing the endpoints used by
+// the client, so the acceptance tests can run without a real instance.
+type fakeReportPortal struct {
+	mu     sync.Mutex
+	server *httptest.Server
+	routes []fakeRoute
+	nextId int
+
+	// the access token currently accepted, replaced on every token grant
+	accessToken    string
+	tokenGrants    int
+	refreshGrants  int
+	tokenExpiresIn int
+	rejectRefresh  bool
+	unauthorized   int
+
+	projects   map[string]*rpClient.Project
+	attributes map[string]map[string]string
+	filters    map[int]*fakeFilter
+	widgets    map[int]*fakeWidget
+	dashboards map[int]*fakeDashboard
+	subTypes   map[int]*fakeIssueSubType
+	patterns   map[int]*fakePatternRule
+	users      map[string]*fakeUser
+	members    map[string]map[string]string
+	apiKeys    map[int]*rpClient.ApiKey
+	ldap       *rpClient.LdapSettings
+	ad         *rpClient.ActiveDirectorySettings
+	saml       map[int]*rpClient.SamlProvider
+	oauth      map[string]*rpClient.OAuthRegistration
+
+	// projects whose analyzer index was deleted, new projects are indexed
+	deletedIndexes map[string]bool
+
+	integrations map[int]*fakeIntegration
+
+	notifications map[string][]rpClient.NotificationRule
+}
+
+type fakeRoute struct {
+	method  string
+	pattern *regexp.Regexp
+	handler func(w http.ResponseWriter, r *http.Request, params []string)
+}
+
+type fakeFilter struct {
+	projectName string
+	filter      rpClient.Filter
+}
+
+type fakeWidget struct {
+	projectName string
+	widget      rpClient.FullWidgetModel
+}
+
+type fakeDashboard struct {
+	projectName string
+	id          int
+	dashboard   rpClient.GetDashboardByIdResponse
+}
+
+type fakeUser struct {
+	id       int
+	login    string
+	email    string
+	fullName string
+	role     string
+	password string
+}
+
+type fakeIntegration struct {
+	projectName string
+	integration rpClient.Integration
+}
+
+type fakePatternRule struct {
+	projectName string
+	pattern     rpClient.PatternRule
+}
+
+type fakeIssueSubType struct {
+	projectName string
+	subType     rpClient.IssueSubType
+}
+
+// fakeDefaultIssueSubTypes are the predefined sub-types which every ReportPortal project shares.
+var fakeDefaultIssueSubTypes = []rpClient.IssueSubType{
+	{Id: 1, Locator: "ti001", TypeRef: "TO_INVESTIGATE", LongName: "To Investigate", ShortName: "TI", Color: "#ffb743"},
+	{Id: 2, Locator: "ab001", TypeRef: "AUTOMATION_BUG", LongName: "Automation Bug", ShortName: "AB", Color: "#f7d63e"},
+	{Id: 3, Locator: "pb001", TypeRef: "PRODUCT_BUG", LongName: "Product Bug", ShortName: "PB", Color: "#ec3900"},
+	{Id: 4, Locator: "nd001", TypeRef: "NO_DEFECT", LongName: "No Defect", ShortName: "ND", Color: "#777777"},
+	{Id: 5, Locator: "si001", TypeRef: "SYSTEM_ISSUE", LongName: "System Issue", ShortName: "SI", Color: "#0274d1"},
+}
+
+type fakeWidgetInput struct {
+	rpClient.WidgetInputPayload
+	FilterIds []int `json:"filterIds"`
+}
+
+// newFakeReportPortal starts a fake ReportPortal which is shut down with the test.
+func newFakeReportPortal(t *testing.T) *fakeReportPortal {
+	f := &fakeReportPortal{
+		nextId: 100,
+
+		accessToken:    fakeAccessToken,
+		tokenExpiresIn: 3600,
+
+		projects:   make(map[string]*rpClient.Project),
+		attributes: make(map[string]map[string]string),
+		filters:    make(map[int]*fakeFilter),
+		widgets:    make(map[int]*fakeWidget),
+		dashboards: make(map[int]*fakeDashboard),
+		subTypes:   make(map[int]*fakeIssueSubType),
+		patterns:   make(map[int]*fakePatternRule),
+		users:      make(map[string]*fakeUser),
+		members:    make(map[string]map[string]string),
+		apiKeys:    make(map[int]*rpClient.ApiKey),
+		saml:       make(map[int]*rpClient.SamlProvider),
+		oauth:      make(map[string]*rpClient.OAuthRegistration),
+
+		deletedIndexes: make(map[string]bool),
+
+		notifications: make(map[string][]rpClient.NotificationRule),
+		integrations:  make(map[int]*fakeIntegration),
+	}
+	f.users[fakeUsername] = &fakeUser{id: f.newId(), login: fakeUsername, email: "superadmin@reportportal.internal", fullName: "tester", role: "ADMINISTRATOR"}
+
+	f.handle("POST", `/uat/sso/oauth/token`, f.token)
+
+	f.handle("GET", `/api/v1/project/list`, f.listProjects)
+	f.handle("GET", `/api/v1/project/list/([^/]+)`, f.getProject)
+	f.handle("POST", `/api/v1/project`, f.createProject)
+	f.handle("DELETE", `/api/v1/project/(\d+)`, f.deleteProject)
+	f.handle("GET", `/api/v1/project/([^/]+)/users`, f.listProjectUsers)
+	f.handle("PUT", `/api/v1/project/([^/]+)/assign`, f.assignProjectUsers)
+	f.handle("PUT", `/api/v1/project/([^/]+)/unassign`, f.unassignProjectUsers)
+	f.handle("PUT", `/api/v1/project/([^/]+)/notification`, f.updateProjectNotifications)
+	f.handle("PUT", `/api/v1/project/([^/]+)/index`, f.generateProjectIndex)
+	f.handle("DELETE", `/api/v1/project/([^/]+)/index`, f.deleteProjectIndex)
+	f.handle("GET", `/api/v1/project/([^/]+)`, f.getProjectConfiguration)
+	f.handle("PUT", `/api/v1/project/([^/]+)`, f.updateProjectConfiguration)
+
+	f.handle("GET", `/api/v1/([^/]+)/filter`, f.listFilters)
+	f.handle("POST", `/api/v1/([^/]+)/filter`, f.createFilter)
+	f.handle("GET", `/api/v1/([^/]+)/filter/(\d+)`, f.getFilter)
+	f.handle("PUT", `/api/v1/([^/]+)/filter/(\d+)`, f.updateFilter)
+	f.handle("DELETE", `/api/v1/([^/]+)/filter/(\d+)`, f.deleteFilter)
+
+	f.handle("POST", `/api/v1/([^/]+)/widget`, f.createWidget)
+	f.handle("GET", `/api/v1/([^/]+)/widget/(\d+)`, f.getWidget)
+	f.handle("PUT", `/api/v1/([^/]+)/widget/(\d+)`, f.updateWidget)
+
+	f.handle("POST", `/api/v1/([^/]+)/dashboard`, f.createDashboard)
+	f.handle("GET", `/api/v1/([^/]+)/dashboard/(\d+)`, f.getDashboard)
+	f.handle("PUT", `/api/v1/([^/]+)/dashboard/(\d+)`, f.updateDashboard)
+	f.handle("PUT", `/api/v1/([^/]+)/dashboard/(\d+)/add`, f.addWidgetIntoDashboard)
+	f.handle("DELETE", `/api/v1/([^/]+)/dashboard/(\d+)`, f.deleteDashboard)
+
+	f.handle("POST", `/api/v1/user`, f.createUser)
+	f.handle("GET", `/api/v1/user`, f.getCurrentUser)
+	f.handle("GET", `/api/v1/user/all`, f.listUsers)
+	f.handle("GET", `/api/v1/user/([^/]+)`, f.getUser)
+	f.handle("PUT", `/api/v1/user/([^/]+)`, f.updateUser)
+	f.handle("DELETE", `/api/v1/user/(\d+)`, f.deleteUser)
+
+	f.handle("POST", `/api/users/(\d+)/api-keys`, f.createApiKey)
+	f.handle("GET", `/api/users/(\d+)/api-keys`, f.listApiKeys)
+	f.handle("DELETE", `/api/users/(\d+)/api-keys/(\d+)`, f.deleteApiKey)
+
+	f.handle("GET", `/api/v1/([^/]+)/settings`, f.getProjectSettings)
+	f.handle("POST", `/api/v1/([^/]+)/settings/sub-type`, f.createIssueSubType)
+	f.handle("PUT", `/api/v1/([^/]+)/settings/sub-type`, f.updateIssueSubTypes)
+	f.handle("DELETE", `/api/v1/([^/]+)/settings/sub-type/(\d+)`, f.deleteIssueSubType)
+	f.handle("POST", `/api/v1/([^/]+)/settings/pattern`, f.createPatternRule)
+	f.handle("PUT", `/api/v1/([^/]+)/settings/pattern/(\d+)`, f.updatePatternRule)
+	f.handle("DELETE", `/api/v1/([^/]+)/settings/pattern/(\d+)`, f.deletePatternRule)
+
+	f.handle("POST", `/api/v1/integration/([^/]+)`, f.createGlobalIntegration)
+	f.handle("GET", `/api/v1/integration/(\d+)`, f.getGlobalIntegration)
+	f.handle("PUT", `/api/v1/integration/(\d+)`, f.updateGlobalIntegration)
+	f.handle("DELETE", `/api/v1/integration/(\d+)`, f.deleteGlobalIntegration)
+	f.handle("POST", `/api/v1/integration/([^/]+)/([^/]+)`, f.createProjectIntegration)
+	f.handle("GET", `/api/v1/integration/([^/]+)/(\d+)`, f.getProjectIntegration)
+	f.handle("PUT", `/api/v1/integration/([^/]+)/(\d+)`, f.updateProjectIntegration)
+	f.handle("DELETE", `/api/v1/integration/([^/]+)/(\d+)`, f.deleteProjectIntegration)
+
+	f.handle("GET", `/uat/settings/auth/ldap`, f.getLdapSettings)
+	f.handle("POST", `/uat/settings/auth/ldap`, f.createLdapSettings)
+	f.handle("PUT", `/uat/settings/auth/ldap/(\d+)`, f.updateLdapSettings)
+	f.handle("GET", `/uat/settings/auth/ad`, f.getActiveDirectorySettings)
+	f.handle("POST", `/uat/settings/auth/ad`, f.createActiveDirectorySettings)
+	f.handle("PUT", `/uat/settings/auth/ad/(\d+)`, f.updateActiveDirectorySettings)
+	f.handle("GET", `/uat/settings/auth/saml`, f.getSamlProviders)
+	f.handle("POST", `/uat/settings/auth/saml`, f.createSamlProvider)
+	f.handle("PUT", `/uat/settings/auth/saml/(\d+)`, f.updateSamlProvider)
+	f.handle("DELETE", `/uat/settings/auth/(\d+)`, f.deleteIntegration)
+
+	f.handle("GET", `/uat/settings/oauth/([^/]+)`, f.getOAuthSettings)
+	f.handle("PUT", `/uat/settings/oauth/([^/]+)`, f.updateOAuthSettings)
+	f.handle("DELETE", `/uat/settings/oauth/([^/]+)`, f.deleteOAuthSettings)
+
+	f.server = httptest.NewServer(http.HandlerFunc(f.serveHTTP))
+	t.Cleanup(f.server.Close)
+
+	return f
+}
+
+func (f *fakeReportPortal) URL() string {
+	return f.server.URL
+}
+
+func (f *fakeReportPortal) handle(method, pattern string, handler func(w http.ResponseWriter, r *http.Request, params []string)) {
+	f.routes = append(f.routes, fakeRoute{
+		method:  method,
+		pattern: regexp.MustCompile("^" + pattern + "$"),
+		handler: handler,
+	})
+}
+
+func (f *fakeReportPortal) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// API tokens never expire, so fakeApiToken is accepted next to the access token issued last
+	authorization := r.Header.Get("Authorization")
+	if r.URL.Path != "/uat/sso/oauth/token" && authorization != "Bearer "+f.accessToken && authorization != "Bearer "+fakeApiToken {
+		f.unauthorized++
+		writeFakeError(w, http.StatusUnauthorized, 40100, "Full authentication is required to access this resource")
+		return
+	}
+
+	for _, route := range f.routes {
+		if route.method != r.Method {
+			continue
+		}
+		if params := route.pattern.FindStringSubmatch(r.URL.Path); params != nil {
+			route.handler(w, r, params[1:])
+			return
+		}
+	}
+
+	writeFakeError(w, http.StatusNotFound, 40400, fmt.Sprintf("No handler for %s %s", r.Method, r.URL.Path))
+}
+
+func (f *fakeReportPortal) newId() int {
+	id := f.nextId
+	f.nextId++
+	return id
+}
+
+func writeFakeJson(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeFakeError(w http.ResponseWriter, status int, errorCode int, message string) {
+	writeFakeJson(w, status, map[string]interface{}{
+		"errorCode": errorCode,
+		"message":   message,
+	})
+}
+
+func readFakeJson(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeFakeError(w, http.StatusBadRequest, 40001, err.Error())
+		return false
+	}
+	return true
+}
+
+func (f *fakeReportPortal) token(w http.ResponseWriter, r *http.Request, _ []string) {
+	if err := r.ParseForm(); err != nil {
+		writeFakeError(w, http.StatusBadRequest, 40001, err.Error())
+		return
+	}
+
+	var valid bool
+	if r.Form.Get("grant_type") == "refresh_token" {
+		f.refreshGrants++
+		valid = !f.rejectRefresh && r.Form.Get("refresh_token") == "fake-refresh-token"
+	} else {
+		valid = r.Form.Get("username") == fakeUsername && r.Form.Get("password") == fakePassword
+	}
+	if !valid {
+		writeFakeJson(w, http.StatusBadRequest, map[string]string{
+			"error":             "invalid_grant",
+			"error_description": "Bad credentials",
+		})
+		return
+	}
+
+	// the first grant issues fakeAccessToken, so the tests can rely on it
+	if f.tokenGrants > 0 {
+		f.accessToken = fmt.Sprintf("%s-%d", fakeAccessToken, f.tokenGrants)
+	}
+	f.tokenGrants++
+
+	writeFakeJson(w, http.StatusOK, map[string]interface{}{
+		"access_token":  f.accessToken,
+		"token_type":    "bearer",
+		"refresh_token": "fake-refresh-token",
+		"expires_in":    f.tokenExpiresIn,
+	})
+}
+
+// revokeAccessToken makes the fake reject the access token issued last, as if it had expired.
+func (f *fakeReportPortal) revokeAccessToken() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.accessToken = "revoked"
+}
+
+// configureTokens sets the lifetime of the issued access tokens and whether refresh grants are refused.
+func (f *fakeReportPortal) configureTokens(expiresIn int, rejectRefresh bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.tokenExpiresIn = expiresIn
+	f.rejectRefresh = rejectRefresh
+}
+
+// tokenStats returns the number of token grants, refresh grants and rejected requests so far.
+func (f *fakeReportPortal) tokenStats() (int, int, int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.tokenGrants, f.refreshGrants, f.unauthorized
+}
+
+// Projects
+
+func (f *fakeReportPortal) listProjects(w http.ResponseWriter, _ *http.Request, _ []string) {
+	content := make([]rpClient.Project, 0, len(f.projects))
+	for _, p := range f.projects {
+		content = append(content, *p)
+	}
+	writeFakeJson(w, http.StatusOK, rpClient.GetAllProjectsResponse{Content: content})
+}
+
+func (f *fakeReportPortal) getProject(w http.ResponseWriter, _ *http.Request, params []string) {
+	p, ok := f.projects[params[0]]
+	if !ok {
+		writeFakeError(w, http.StatusNotFound, 40422, fmt.Sprintf("Project '%s' not found. Did you use correct project name?", params[0]))
+		return
+	}
+
+	writeFakeJson(w, http.StatusOK, rpClient.GetProjectByNameResponse{
+		Project:         *p,
+		LaunchesPerUser: []rpClient.LaunchPerUser{},
+		Organization:    "",
+		UsersQuantity:   p.UsersQuantity,
+	})
+}
+
+func (f *fakeReportPortal) createProject(w http.ResponseWriter, r *http.Request, _ []string) {
+	var req rpClient.CreateProjectRequest
+	if !readFakeJson(w, r, &req) {
+		return
+	}
+
+	if _, ok := f.projects[req.ProjectName]; ok {
+		writeFakeError(w, http.StatusConflict, 40901, fmt.Sprintf("Project with name '%s' already exists.", req.ProjectName))
+		return
+	}
+
+	p := &rpClient.Project{
+		Id:            f.newId(),
+		ProjectName:   req.ProjectName,
+		EntryType:     req.EntryType,
+		UsersQuantity: 1,
+		CreationDate:  1620000000000,
+	}
+	f.projects[p.ProjectName] = p
+	f.members[p.ProjectName] = map[string]string{fakeUsername: "PROJECT_MANAGER"}
+	f.attributes[p.ProjectName] = defaultFakeProjectAttributes()
+
+	writeFakeJson(w, http.StatusCreated, rpClient.CreateProjectResponse{Id: p.Id})
+}
+
+func defaultFakeProjectAttributes() map[string]string {
+	return map[string]string{
+		rpClient.ProjectAttributeInterruptJobTime:       "86400",
+		rpClient.ProjectAttributeKeepLaunches:           "7776000",
+		rpClient.ProjectAttributeKeepLogs:               "7776000",
+		rpClient.ProjectAttributeKeepScreenshots:        "1209600",
+		rpClient.ProjectAttributeAutoAnalyzerEnabled:    "true",
+		rpClient.ProjectAttributePatternAnalysisEnabled: "false",
+		rpClient.ProjectAttributeNotificationsEnabled:   "false",
+
+		rpClient.ProjectAttributeAnalyzerMode:                   "LAUNCH_NAME",
+		rpClient.ProjectAttributeAnalyzerMinShouldMatch:         "95",
+		rpClient.ProjectAttributeAnalyzerMinDocFreq:             "1",
+		rpClient.ProjectAttributeAnalyzerNumberOfLogLines:       "-1",
+		rpClient.ProjectAttributeAnalyzerAllMessagesShouldMatch: "false",
+		rpClient.ProjectAttributeAnalyzerIndexingRunning:        "false",
+	}
+}
+
+func (f *fakeReportPortal) getProjectConfiguration(w http.ResponseWriter, _ *http.Request, params []string) {
+	p, ok := f.projects[params[0]]
+	if !ok {
+		writeFakeError(w, http.StatusNotFound, 40422, fmt.Sprintf("Project '%s' not found. Did you use correct project name?", params[0]))
+		return
+	}
+
+	writeFakeJson(w, http.StatusOK, rpClient.ProjectResource{
+		ProjectId:   p.Id,
+		ProjectName: p.ProjectName,
+		EntryType:   p.EntryType,
+		Configuration: rpClient.ProjectConfiguration{
+			Attributes:                 f.attributes[p.ProjectName],
+			NotificationsConfiguration: &rpClient.NotificationsConfiguration{Cases: f.notifications[p.ProjectName]},
+		},
+	})
+}
+
+func (f *fakeReportPortal) updateProjectNotifications(w http.ResponseWriter, r *http.Request, params []string) {
+	if !f.lookupProject(w, params[0]) {
+		return
+	}
+
+	var req rpClient.ProjectNotifications
+	if !readFakeJson(w, r, &req) {
+		return
+	}
+
+	for i := range req.Cases {
+		if len(req.Cases[i].Recipients) == 0 {
+			writeFakeError(w, http.StatusBadRequest, 40016, "Recipients list should not be empty")
+			return
+		}
+		req.Cases[i].Id = f.newId()
+	}
+	f.notifications[params[0]] = req.Cases
+	f.attributes[params[0]][rpClient.ProjectAttributeNotificationsEnabled] = strconv.FormatBool(req.Enabled)
+
+	writeFakeJson(w, http.StatusOK, map[string]string{"message": "Notification configuration was updated"})
+}
+
+// projectNotifications returns the notification rules of the project as stored by ReportPortal.
+func (f *fakeReportPortal) projectNotifications(name string) []rpClient.NotificationRule {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.notifications[name]
+}
+
+func (f *fakeReportPortal) updateProjectConfiguration(w http.ResponseWriter, r *http.Request, params []string) {
+	if _, ok := f.projects[params[0]]; !ok {
+		writeFakeError(w, http.StatusNotFound, 40422, fmt.Sprintf("Project '%s' not found. Did you use correct project name?", params[0]))
+		return
+	}
+
+	var req rpClient.UpdateProjectRequest
+	if !readFakeJson(w, r, &req) {
+		return
+	}
+
+	if req.Configuration != nil {
+		for k, v := range req.Configuration.Attributes {
+			f.attributes[params[0]][k] = v
+		}
+	}
+	for login, role := range req.Users {
+		if _, ok := f.members[params[0]][login]; !ok {
+			writeFakeError(w, http.StatusNotFound, 40420, fmt.Sprintf("User '%s' not found.", login))
+			return
+		}
+		f.members[params[0]][login] = role
+	}
+
+	writeFakeJson(w, http.StatusOK, map[string]string{"message": "Project updated"})
+}
+
+func (f *fakeReportPortal) generateProjectIndex(w http.ResponseWriter, _ *http.Request, params []string) {
+	if !f.lookupProject(w, params[0]) {
+		return
+	}
+
+	delete(f.deletedIndexes, params[0])
+
+	writeFakeJson(w, http.StatusOK, map[string]string{"message": "Log indexing has been started"})
+}
+
+func (f *fakeReportPortal) deleteProjectIndex(w http.ResponseWriter, _ *http.Request, params []string) {
+	if !f.lookupProject(w, params[0]) {
+		return
+	}
+
+	f.deletedIndexes[params[0]] = true
+
+	writeFakeJson(w, http.StatusOK, map[string]string{"message": "Project index with name = '" + params[0] + "' is successfully deleted."})
+}
+
+// hasProjectIndex reports whether the analyzer index of the project exists.
+func (f *fakeReportPortal) hasProjectIndex(name string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return !f.deletedIndexes[name]
+}
+
+// projectAttribute returns a configuration attribute of the project as stored by ReportPortal.
+func (f *fakeReportPortal) projectAttribute(name, attribute string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.attributes[name][attribute]
+}
+
+func (f *fakeReportPortal) deleteProject(w http.ResponseWriter, _ *http.Request, params []string) {
+	id, _ := strconv.Atoi(params[0])
+	for name, p := range f.projects {
+		if p.Id == id {
+			delete(f.projects, name)
+			delete(f.attributes, name)
+			delete(f.members, name)
+			delete(f.notifications, name)
+			writeFakeJson(w, http.StatusOK, map[string]string{"message": "Project deleted"})
+			return
+		}
+	}
+	writeFakeError(w, http.StatusNotFound, 40422, fmt.Sprintf("Project '%d' not found.", id))
+}
+
+// addProject registers a project as if it was created outside of Terraform.
+func (f *fakeReportPortal) addProject(name string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	p := &rpClient.Project{
+		Id:          f.newId(),
+		ProjectName: name,
+		EntryType:   "INTERNAL",
+	}
+	f.projects[name] = p
+	f.members[name] = map[string]string{fakeUsername: "PROJECT_MANAGER"}
+	f.attributes[name] = defaultFakeProjectAttributes()
+	return p.Id
+}
+
+func (f *fakeReportPortal) hasProject(name string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	_, ok := f.projects[name]
+	return ok
+}
+
+func (f *fakeReportPortal) removeProject(name string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.projects, name)
+	delete(f.attributes, name)
+	delete(f.members, name)
+	delete(f.notifications, name)
+}
+
+// Project members
+
+func (f *fakeReportPortal) toUser(user *fakeUser) rpClient.User {
+	assignedProjects := make(map[string]rpClient.AssignedProject)
+	for projectName, members := range f.members {
+		if role, ok := members[user.login]; ok {
+			assignedProjects[projectName] = rpClient.AssignedProject{ProjectRole: role, EntryType: "INTERNAL"}
+		}
+	}
+
+	return rpClient.User{
+		Id:               user.id,
+		UserId:           user.login,
+		Email:            user.email,
+		FullName:         user.fullName,
+		AccountType:      "INTERNAL",
+		UserRole:         user.role,
+		AssignedProjects: assignedProjects,
+	}
+}
+
+func (f *fakeReportPortal) listProjectUsers(w http.ResponseWriter, _ *http.Request, params []string) {
+	if !f.lookupProject(w, params[0]) {
+		return
+	}
+
+	content := make([]rpClient.User, 0)
+	for login := range f.members[params[0]] {
+		if user, ok := f.users[login]; ok {
+			content = append(content, f.toUser(user))
+		}
+	}
+
+	writeFakeJson(w, http.StatusOK, rpClient.GetProjectUsersResponse{
+		Content: content,
+		Page: rpClient.PaginationResponse{
+			Number:        1,
+			Size:          len(content),
+			TotalElements: len(content),
+			TotalPages:    1,
+		},
+	})
+}
+
+func (f *fakeReportPortal) assignProjectUsers(w http.ResponseWriter, r *http.Request, params []string) {
+	if !f.lookupProject(w, params[0]) {
+		return
+	}
+
+	var req rpClient.AssignProjectUsersRequest
+	if !readFakeJson(w, r, &req) {
+		return
+	}
+
+	for login := range req.UserNames {
+		if _, ok := f.users[login]; !ok {
+			writeFakeError(w, http.StatusNotFound, 40420, fmt.Sprintf("User '%s' not found.", login))
+			return
+		}
+		if _, ok := f.members[params[0]][login]; ok {
+			writeFakeError(w, http.StatusConflict, 40901, fmt.Sprintf("User '%s' cannot be assigned to project twice.", login))
+			return
+		}
+	}
+	for login, role := range req.UserNames {
+		f.members[params[0]][login] = role
+	}
+
+	writeFakeJson(w, http.StatusOK, map[string]string{"message": "User(s) were successfully assigned"})
+}
+
+func (f *fakeReportPortal) unassignProjectUsers(w http.ResponseWriter, r *http.Request, params []string) {
+	if !f.lookupProject(w, params[0]) {
+		return
+	}
+
+	var req rpClient.UnassignProjectUsersRequest
+	if !readFakeJson(w, r, &req) {
+		return
+	}
+
+	for _, login := range req.UserNames {
+		if _, ok := f.members[params[0]][login]; !ok {
+			writeFakeError(w, http.StatusBadRequest, 40018, fmt.Sprintf("User '%s' not assigned to the project", login))
+			return
+		}
+	}
+	for _, login := range req.UserNames {
+		delete(f.members[params[0]], login)
+	}
+
+	writeFakeJson(w, http.StatusOK, map[string]string{"message": "User(s) were successfully unassigned"})
+}
+
+// Users
+
+func (f *fakeReportPortal) createUser(w http.ResponseWriter, r *http.Request, _ []string) {
+	var req rpClient.CreateUserRequest
+	if !readFakeJson(w, r, &req) {
+		return
+	}
+
+	if _, ok := f.users[req.Login]; ok {
+		writeFakeError(w, http.StatusConflict, 40901, fmt.Sprintf("User with login '%s' already exists.", req.Login))
+		return
+	}
+	if !f.lookupProject(w, req.DefaultProject) {
+		return
+	}
+
+	user := &fakeUser{
+		id:       f.newId(),
+		login:    req.Login,
+		email:    req.Email,
+		fullName: req.FullName,
+		role:     req.AccountRole,
+		password: req.Password,
+	}
+	f.users[user.login] = user
+	f.members[req.DefaultProject][user.login] = req.ProjectRole
+
+	writeFakeJson(w, http.StatusCreated, rpClient.CreateUserResponse{Id: user.id, Login: user.login})
+}
+
+func (f *fakeReportPortal) listUsers(w http.ResponseWriter, r *http.Request, _ []string) {
+	login := r.URL.Query().Get("filter.cnt.user")
+	email := r.URL.Query().Get("filter.cnt.email")
+
+	content := make([]rpClient.User, 0)
+	for _, user := range f.users {
+		if strings.Contains(user.login, login) && strings.Contains(user.email, email) {
+			content = append(content, f.toUser(user))
+		}
+	}
+	sort.Slice(content, func(a, b int) bool { return content[a].Id < content[b].Id })
+
+	writeFakeJson(w, http.StatusOK, rpClient.GetUsersResponse{
+		Content: content,
+		Page: rpClient.PaginationResponse{
+			Number:        1,
+			Size:          len(content),
+			TotalElements: len(content),
+			TotalPages:    1,
+		},
+	})
+}
+
+func (f *fakeReportPortal) lookupUser(w http.ResponseWriter, login string) *fakeUser {
+	user, ok := f.users[login]
+	if !ok {
+		writeFakeError(w, http.StatusNotFound, 40420, fmt.Sprintf("User '%s' not found.", login))
+		return nil
+	}
+	return user
+}
+
+// getCurrentUser answers as fakeUsername, which both the password and the API token authenticate
+func (f *fakeReportPortal) getCurrentUser(w http.ResponseWriter, _ *http.Request, _ []string) {
+	writeFakeJson(w, http.StatusOK, f.toUser(f.users[fakeUsername]))
+}
+
+func (f *fakeReportPortal) getUser(w http.ResponseWriter, _ *http.Request, params []string) {
+	if user := f.lookupUser(w, params[0]); user != nil {
+		writeFakeJson(w, http.StatusOK, f.toUser(user))
+	}
+}
+
+func (f *fakeReportPortal) updateUser(w http.ResponseWriter, r *http.Request, params []string) {
+	user := f.lookupUser(w, params[0])
+	if user == nil {
+		return
+	}
+
+	var req rpClient.UpdateUserRequest
+	if !readFakeJson(w, r, &req) {
+		return
+	}
+
+	user.email = req.Email
+	user.fullName = req.FullName
+	user.role = req.Role
+
+	writeFakeJson(w, http.StatusOK, map[string]string{"message": fmt.Sprintf("User with login = '%s' successfully updated", user.login)})
+}
+
+func (f *fakeReportPortal) deleteUser(w http.ResponseWriter, _ *http.Request, params []string) {
+	id, _ := strconv.Atoi(params[0])
+	for login, user := range f.users {
+		if user.id == id {
+			delete(f.users, login)
+			for _, members := range f.members {
+				delete(members, login)
+			}
+			writeFakeJson(w, http.StatusOK, map[string]string{"message": fmt.Sprintf("User with ID = '%d' successfully deleted.", id)})
+			return
+		}
+	}
+	writeFakeError(w, http.StatusNotFound, 40420, fmt.Sprintf("User '%d' not found.", id))
+}
+
+func (f *fakeReportPortal) hasUser(login string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	_, ok := f.users[login]
+	return ok
+}
+
+func (f *fakeReportPortal) userPassword(login string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if user, ok := f.users[login]; ok {
+		return user.password
+	}
+	return ""
+}
+
+func (f *fakeReportPortal) removeUser(login string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.users, login)
+	for _, members := range f.members {
+		delete(members, login)
+	}
+}
+
+// API keys
+
+func (f *fakeReportPortal) lookupUserById(w http.ResponseWriter, userId string) *fakeUser {
+	id, _ := strconv.Atoi(userId)
+	for _, user := range f.users {
+		if user.id == id {
+			return user
+		}
+	}
+	writeFakeError(w, http.StatusNotFound, 40420, fmt.Sprintf("User '%d' not found.", id))
+	return nil
+}
+
+func (f *fakeReportPortal) createApiKey(w http.ResponseWriter, r *http.Request, params []string) {
+	user := f.lookupUserById(w, params[0])
+	if user == nil {
+		return
+	}
+
+	var req rpClient.CreateApiKeyRequest
+	if !readFakeJson(w, r, &req) {
+		return
+	}
+
+	for _, key := range f.apiKeys {
+		if key.UserId == user.id && key.Name == req.Name {
+			writeFakeError(w, http.StatusConflict, 40901, fmt.Sprintf("API key with name '%s' already exists.", req.Name))
+			return
+		}
+	}
+
+	key := &rpClient.ApiKey{Id: f.newId(), Name: req.Name, UserId: user.id}
+	f.apiKeys[key.Id] = key
+
+	created := *key
+	created.ApiKey = fmt.Sprintf("%s_fake-api-key-%d", req.Name, key.Id)
+	writeFakeJson(w, http.StatusCreated, created)
+}
+
+func (f *fakeReportPortal) listApiKeys(w http.ResponseWriter, _ *http.Request, params []string) {
+	user := f.lookupUserById(w, params[0])
+	if user == nil {
+		return
+	}
+
+	items := make([]rpClient.ApiKey, 0)
+	for _, key := range f.apiKeys {
+		if key.UserId == user.id {
+			items = append(items, *key)
+		}
+	}
+
+	writeFakeJson(w, http.StatusOK, rpClient.GetApiKeysResponse{Items: items})
+}
+
+func (f *fakeReportPortal) deleteApiKey(w http.ResponseWriter, _ *http.Request, params []string) {
+	userId, _ := strconv.Atoi(params[0])
+	keyId, _ := strconv.Atoi(params[1])
+	key, ok := f.apiKeys[keyId]
+	if !ok || key.UserId != userId {
+		writeFakeError(w, http.StatusNotFound, 40400, fmt.Sprintf("API key with ID '%d' not found.", keyId))
+		return
+	}
+
+	delete(f.apiKeys, keyId)
+	writeFakeJson(w, http.StatusOK, map[string]string{"message": fmt.Sprintf("API key with ID = '%d' was successfully deleted.", keyId)})
+}
+
+func (f *fakeReportPortal) hasApiKey(id int) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	_, ok := f.apiKeys[id]
+	return ok
+}
+
+func (f *fakeReportPortal) removeApiKey(id int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.apiKeys, id)
+}
+
+// addUser registers a user as if it was created outside of Terraform.
+func (f *fakeReportPortal) addUser(login string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.users[login] = &fakeUser{id: f.newId(), login: login, email: login + "@example.com", fullName: login, role: "USER"}
+}
+
+// projectMemberRole returns the role of the user in the project, or an empty string if not assigned.
+func (f *fakeReportPortal) projectMemberRole(projectName, login string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.members[projectName][login]
+}
+
+func (f *fakeReportPortal) assignProjectMember(projectName, login, role string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.members[projectName][login] = role
+}
+
+func (f *fakeReportPortal) unassignProjectMember(projectName, login string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.members[projectName], login)
+}
+
+// Project settings
+
+func (f *fakeReportPortal) lookupProject(w http.ResponseWriter, name string) bool {
+	if _, ok := f.projects[name]; !ok {
+		writeFakeError(w, http.StatusNotFound, 40422, fmt.Sprintf("Project '%s' not found. Did you use correct project name?", name))
+		return false
+	}
+	return true
+}
+
+func (f *fakeReportPortal) getProjectSettings(w http.ResponseWriter, _ *http.Request, params []string) {
+	if !f.lookupProject(w, params[0]) {
+		return
+	}
+
+	subTypes := make(map[string][]rpClient.IssueSubType)
+	for _, subType := range fakeDefaultIssueSubTypes {
+		subTypes[subType.TypeRef] = append(subTypes[subType.TypeRef], subType)
+	}
+	for _, subType := range f.subTypes {
+		if subType.projectName == params[0] {
+			subTypes[subType.subType.TypeRef] = append(subTypes[subType.subType.TypeRef], subType.subType)
+		}
+	}
+
+	patterns := make([]rpClient.PatternRule, 0)
+	for _, pattern := range f.patterns {
+		if pattern.projectName == params[0] {
+			patterns = append(patterns, pattern.pattern)
+		}
+	}
+	sort.Slice(patterns, func(a, b int) bool { return patterns[a].Id < patterns[b].Id })
+
+	writeFakeJson(w, http.StatusOK, rpClient.ProjectSettings{
+		Project:  f.projects[params[0]].Id,
+		SubTypes: subTypes,
+		Patterns: patterns,
+	})
+}
+
+func (f *fakeReportPortal) createIssueSubType(w http.ResponseWriter, r *http.Request, params []string) {
+	if !f.lookupProject(w, params[0]) {
+		return
+	}
+
+	var subType rpClient.IssueSubType
+	if !readFakeJson(w, r, &subType) {
+		return
+	}
+
+	subType.Id = f.newId()
+	subType.Locator = fmt.Sprintf("%s_%d", strings.ToLower(subType.TypeRef[:1]), subType.Id)
+	f.subTypes[subType.Id] = &fakeIssueSubType{projectName: params[0], subType: subType}
+
+	writeFakeJson(w, http.StatusCreated, rpClient.CreateIssueSubTypeResponse{Id: subType.Id, Locator: subType.Locator})
+}
+
+func (f *fakeReportPortal) updateIssueSubTypes(w http.ResponseWriter, r *http.Request, params []string) {
+	var req rpClient.UpdateIssueSubTypesRequest
+	if !readFakeJson(w, r, &req) {
+		return
+	}
+
+	// ReportPortal finds the sub-types to update by locator, the id is not part of the request
+	for _, update := range req.Ids {
+		if update.Locator == "" {
+			writeFakeError(w, http.StatusBadRequest, 4001, "Incorrect Request. [Field 'locator' should not be null.]")
+			return
+		}
+
+		var subType *fakeIssueSubType
+		for _, s := range f.subTypes {
+			if s.projectName == params[0] && s.subType.Locator == update.Locator {
+				subType = s
+			}
+		}
+		if subType == nil {
+			writeFakeError(w, http.StatusNotFound, 40416, fmt.Sprintf("Issue Type '%s' not found.", update.Locator))
+			return
+		}
+		if update.TypeRef != subType.subType.TypeRef {
+			writeFakeError(w, http.StatusBadRequest, 40016, "Issue sub-type group can not be changed.")
+			return
+		}
+
+		update.Id = subType.subType.Id
+		subType.subType = update
+	}
+
+	writeFakeJson(w, http.StatusOK, map[string]string{"message": "Issue sub-type(s) was updated successfully."})
+}
+
+func (f *fakeReportPortal) deleteIssueSubType(w http.ResponseWriter, _ *http.Request, params []string) {
+	id, _ := strconv.Atoi(params[1])
+	subType, ok := f.subTypes[id]
+	if !ok || subType.projectName != params[0] {
+		writeFakeError(w, http.StatusNotFound, 40416, fmt.Sprintf("Issue Type '%d' not found.", id))
+		return
+	}
+
+	delete(f.subTypes, id)
+	writeFakeJson(w, http.StatusOK, map[string]string{"message": "Issue sub-type delete was successful."})
+}
+
+func (f *fakeReportPortal) hasIssueSubType(id int) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	_, ok := f.subTypes[id]
+	return ok
+}
+
+func (f *fakeReportPortal) removeIssueSubType(id int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.subTypes, id)
+}
+
+func (f *fakeReportPortal) createPatternRule(w http.ResponseWriter, r *http.Request, params []string) {
+	if !f.lookupProject(w, params[0]) {
+		return
+	}
+
+	var pattern rpClient.PatternRule
+	if !readFakeJson(w, r, &pattern) {
+		return
+	}
+
+	for _, existing := range f.patterns {
+		if existing.projectName == params[0] && existing.pattern.Name == pattern.Name {
+			writeFakeError(w, http.StatusConflict, 40911, fmt.Sprintf("Pattern template with name '%s' already exists.", pattern.Name))
+			return
+		}
+	}
+
+	pattern.Id = f.newId()
+	f.patterns[pattern.Id] = &fakePatternRule{projectName: params[0], pattern: pattern}
+
+	writeFakeJson(w, http.StatusCreated, rpClient.CreatePatternRuleResponse{Id: pattern.Id})
+}
+
+func (f *fakeReportPortal) lookupPatternRule(w http.ResponseWriter, params []string) *fakePatternRule {
+	id, _ := strconv.Atoi(params[1])
+	pattern, ok := f.patterns[id]
+	if !ok || pattern.projectName != params[0] {
+		writeFakeError(w, http.StatusNotFound, 40424, fmt.Sprintf("Pattern template with ID '%d' not found.", id))
+		return nil
+	}
+	return pattern
+}
+
+func (f *fakeReportPortal) updatePatternRule(w http.ResponseWriter, r *http.Request, params []string) {
+	pattern := f.lookupPatternRule(w, params)
+	if pattern == nil {
+		return
+	}
+
+	var req rpClient.UpdatePatternRuleRequest
+	if !readFakeJson(w, r, &req) {
+		return
+	}
+
+	pattern.pattern.Name = req.Name
+	pattern.pattern.Enabled = req.Enabled
+
+	writeFakeJson(w, http.StatusOK, map[string]string{"message": fmt.Sprintf("Pattern template with ID = '%d' has been successfully updated", pattern.pattern.Id)})
+}
+
+func (f *fakeReportPortal) deletePatternRule(w http.ResponseWriter, _ *http.Request, params []string) {
+	if pattern := f.lookupPatternRule(w, params); pattern != nil {
+		delete(f.patterns, pattern.pattern.Id)
+		writeFakeJson(w, http.StatusOK, map[string]string{"message": fmt.Sprintf("Pattern template with ID = '%d' has been successfully deleted", pattern.pattern.Id)})
+	}
+}
+
+func (f *fakeReportPortal) hasPatternRule(id int) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	_, ok := f.patterns[id]
+	return ok
+}
+
+func (f *fakeReportPortal) removePatternRule(id int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.patterns, id)
+}
+
+// Filters
+
+func (f *fakeReportPortal) lookupFilter(w http.ResponseWriter, params []string) *fakeFilter {
+	id, _ := strconv.Atoi(params[1])
+	filter, ok := f.filters[id]
+	if !ok || filter.projectName != params[0] {
+		writeFakeError(w, http.StatusNotFound, 40421, fmt.Sprintf("User filter with ID '%d' not found on project '%s'.", id, params[0]))
+		return nil
+	}
+	return filter
+}
+
+func (f *fakeReportPortal) listFilters(w http.ResponseWriter, _ *http.Request, params []string) {
+	content := make([]rpClient.Filter, 0)
+	for _, filter := range f.filters {
+		if filter.projectName == params[0] {
+			content = append(content, filter.filter)
+		}
+	}
+
+	writeFakeJson(w, http.StatusOK, rpClient.GetFiltersByProjectResponse{
+		Content: content,
+		Page: rpClient.PaginationResponse{
+			Number:        1,
+			Size:          len(content),
+			TotalElements: len(content),
+			TotalPages:    1,
+		},
+	})
+}
+
+func (f *fakeReportPortal) createFilter(w http.ResponseWriter, r *http.Request, params []string) {
+	var filter rpClient.Filter
+	if !readFakeJson(w, r, &filter) {
+		return
+	}
+
+	filter.Id = f.newId()
+	filter.Owner = fakeUsername
+	f.filters[filter.Id] = &fakeFilter{projectName: params[0], filter: filter}
+
+	writeFakeJson(w, http.StatusCreated, rpClient.CreateFilterByProjectResponse{Id: filter.Id})
+}
+
+func (f *fakeReportPortal) getFilter(w http.ResponseWriter, _ *http.Request, params []string) {
+	if filter := f.lookupFilter(w, params); filter != nil {
+		writeFakeJson(w, http.StatusOK, filter.filter)
+	}
+}
+
+func (f *fakeReportPortal) updateFilter(w http.ResponseWriter, r *http.Request, params []string) {
+	filter := f.lookupFilter(w, params)
+	if filter == nil {
+		return
+	}
+
+	var update rpClient.Filter
+	if !readFakeJson(w, r, &update) {
+		return
+	}
+
+	update.Id = filter.filter.Id
+	update.Owner = filter.filter.Owner
+	filter.filter = update
+
+	writeFakeJson(w, http.StatusOK, map[string]string{"message": "User filter updated"})
+}
+
+func (f *fakeReportPortal) deleteFilter(w http.ResponseWriter, _ *http.Request, params []string) {
+	if filter := f.lookupFilter(w, params); filter != nil {
+		delete(f.filters, filter.filter.Id)
+		writeFakeJson(w, http.StatusOK, map[string]string{"message": "User filter deleted"})
+	}
+}
+
+func (f *fakeReportPortal) hasFilter(id int) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	_, ok := f.filters[id]
+	return ok
+}
+
+func (f *fakeReportPortal) removeFilter(id int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.filters, id)
+}
+
+// Widgets
+
+func (f *fakeReportPortal) lookupWidget(w http.ResponseWriter, params []string) *fakeWidget {
+	id, _ := strconv.Atoi(params[1])
+	widget, ok := f.widgets[id]
+	if !ok || widget.projectName != params[0] {
+		writeFakeError(w, http.StatusNotFound, 40411, fmt.Sprintf("Widget with ID '%d' not found on project '%s'.", id, params[0]))
+		return nil
+	}
+	return widget
+}
+
+func (f *fakeReportPortal) toWidgetModel(id int, input *fakeWidgetInput) rpClient.FullWidgetModel {
+	appliedFilters := make([]rpClient.Filter, 0, len(input.FilterIds))
+	for _, filterId := range input.FilterIds {
+		if filter, ok := f.filters[filterId]; ok {
+			appliedFilters = append(appliedFilters, filter.filter)
+		}
+	}
+
+	return rpClient.FullWidgetModel{
+		AppliedFilters:    appliedFilters,
+		ContentParameters: input.ContentParameters,
+		Description:       input.Description,
+		Id:                id,
+		Name:              input.Name,
+		Owner:             fakeUsername,
+		Share:             input.Share,
+		WidgetType:        input.WidgetType,
+	}
+}
+
+func (f *fakeReportPortal) createWidget(w http.ResponseWriter, r *http.Request, params []string) {
+	var input fakeWidgetInput
+	if !readFakeJson(w, r, &input) {
+		return
+	}
+
+	id := f.newId()
+	f.widgets[id] = &fakeWidget{projectName: params[0], widget: f.toWidgetModel(id, &input)}
+
+	writeFakeJson(w, http.StatusCreated, rpClient.WidgetCreationResponseModel{Id: id})
+}
+
+func (f *fakeReportPortal) getWidget(w http.ResponseWriter, _ *http.Request, params []string) {
+	if widget := f.lookupWidget(w, params); widget != nil {
+		writeFakeJson(w, http.StatusOK, widget.widget)
+	}
+}
+
+func (f *fakeReportPortal) updateWidget(w http.ResponseWriter, r *http.Request, params []string) {
+	widget := f.lookupWidget(w, params)
+	if widget == nil {
+		return
+	}
+
+	var input fakeWidgetInput
+	if !readFakeJson(w, r, &input) {
+		return
+	}
+
+	widget.widget = f.toWidgetModel(widget.widget.Id, &input)
+
+	writeFakeJson(w, http.StatusOK, rpClient.WidgetCreationResponseModel{Id: widget.widget.Id})
+}
+
+func (f *fakeReportPortal) hasWidget(id int) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	_, ok := f.widgets[id]
+	return ok
+}
+
+func (f *fakeReportPortal) widgetName(id int) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if widget, ok := f.widgets[id]; ok {
+		return widget.widget.Name
+	}
+	return ""
+}
+
+func (f *fakeReportPortal) widgetOptions(id int) map[string]interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if widget, ok := f.widgets[id]; ok {
+		return widget.widget.ContentParameters.WidgetOptions
+	}
+	return nil
+}
+
+func (f *fakeReportPortal) removeWidget(id int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.widgets, id)
+}
+
+// Dashboards
+
+func (f *fakeReportPortal) lookupDashboard(w http.ResponseWriter, params []string) *fakeDashboard {
+	id, _ := strconv.Atoi(params[1])
+	dashboard, ok := f.dashboards[id]
+	if !ok || dashboard.projectName != params[0] {
+		writeFakeError(w, http.StatusNotFound, 40412, fmt.Sprintf("Dashboard with ID '%d' not found on project '%s'.", id, params[0]))
+		return nil
+	}
+	return dashboard
+}
+
+func (f *fakeReportPortal) createDashboard(w http.ResponseWriter, r *http.Request, params []string) {
+	var req rpClient.CreateDashboardRequest
+	if !readFakeJson(w, r, &req) {
+		return
+	}
+
+	id := f.newId()
+	f.dashboards[id] = &fakeDashboard{
+		projectName: params[0],
+		id:          id,
+		dashboard: rpClient.GetDashboardByIdResponse{
+			Description: req.Description,
+			Name:        req.Name,
+			Owner:       fakeUsername,
+			Share:       req.Share,
+			Widgets:     []rpClient.Widget{},
+		},
+	}
+
+	writeFakeJson(w, http.StatusCreated, rpClient.CreateDashboardResponse{Id: id})
+}
+
+func (f *fakeReportPortal) getDashboard(w http.ResponseWriter, _ *http.Request, params []string) {
+	if dashboard := f.lookupDashboard(w, params); dashboard != nil {
+		writeFakeJson(w, http.StatusOK, dashboard.dashboard)
+	}
+}
+
+func (f *fakeReportPortal) updateDashboard(w http.ResponseWriter, r *http.Request, params []string) {
+	dashboard := f.lookupDashboard(w, params)
+	if dashboard == nil {
+		return
+	}
+
+	var req rpClient.UpdateDashboardRequest
+	if !readFakeJson(w, r, &req) {
+		return
+	}
+
+	dashboard.dashboard.Name = req.Name
+	dashboard.dashboard.Description = req.Description
+	dashboard.dashboard.Share = req.Share
+	if req.UpdateWidgets != nil {
+		dashboard.dashboard.Widgets = req.UpdateWidgets
+	}
+
+	writeFakeJson(w, http.StatusOK, map[string]string{"message": "Dashboard updated"})
+}
+
+func (f *fakeReportPortal) addWidgetIntoDashboard(w http.ResponseWriter, r *http.Request, params []string) {
+	dashboard := f.lookupDashboard(w, params)
+	if dashboard == nil {
+		return
+	}
+
+	var req rpClient.AddWidgetRequest
+	if !readFakeJson(w, r, &req) {
+		return
+	}
+
+	dashboard.dashboard.Widgets = append(dashboard.dashboard.Widgets, req.AddWidget)
+
+	writeFakeJson(w, http.StatusOK, map[string]string{"message": "Widget added"})
+}
+
+func (f *fakeReportPortal) deleteDashboard(w http.ResponseWriter, _ *http.Request, params []string) {
+	if dashboard := f.lookupDashboard(w, params); dashboard != nil {
+		delete(f.dashboards, dashboard.id)
+		writeFakeJson(w, http.StatusOK, map[string]string{"message": "Dashboard deleted"})
+	}
+}
+
+func (f *fakeReportPortal) hasDashboard(id int) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	_, ok := f.dashboards[id]
+	return ok
+}
+
+func (f *fakeReportPortal) removeDashboard(id int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.dashboards, id)
+}
+
+// Integrations
+
+// fakeSecretIntegrationParameters are stored but, as in ReportPortal, never returned.
+var fakeSecretIntegrationParameters = []string{"password", "oauthAccessKey"}
+
+func (f *fakeReportPortal) createIntegration(w http.ResponseWriter, r *http.Request, projectName, pluginName string) {
+	var req rpClient.IntegrationPayload
+	if !readFakeJson(w, r, &req) {
+		return
+	}
+
+	for _, existing := range f.integrations {
+		if existing.projectName == projectName && existing.integration.Name == req.Name {
+			writeFakeError(w, http.StatusConflict, 40911, fmt.Sprintf("Integration with name '%s' already exists.", req.Name))
+			return
+		}
+	}
+
+	integration := rpClient.Integration{
+		Id:                    f.newId(),
+		Name:                  req.Name,
+		Enabled:               req.Enabled,
+		IntegrationParameters: req.IntegrationParameters,
+		IntegrationType:       &rpClient.IntegrationType{Id: 1, Name: pluginName},
+	}
+	f.integrations[integration.Id] = &fakeIntegration{projectName: projectName, integration: integration}
+
+	writeFakeJson(w, http.StatusCreated, rpClient.CreateIntegrationResponse{Id: integration.Id})
+}
+
+func (f *fakeReportPortal) lookupIntegration(w http.ResponseWriter, projectName, integrationId string) *fakeIntegration {
+	id, _ := strconv.Atoi(integrationId)
+	integration, ok := f.integrations[id]
+	if !ok || integration.projectName != projectName {
+		writeFakeError(w, http.StatusNotFound, 40418, fmt.Sprintf("Integration with ID '%d' not found.", id))
+		return nil
+	}
+	return integration
+}
+
+func (f *fakeReportPortal) getIntegration(w http.ResponseWriter, projectName, integrationId string) {
+	integration := f.lookupIntegration(w, projectName, integrationId)
+	if integration == nil {
+		return
+	}
+
+	response := integration.integration
+	response.IntegrationParameters = make(map[string]interface{})
+	for k, v := range integration.integration.IntegrationParameters {
+		response.IntegrationParameters[k] = v
+	}
+	for _, k := range fakeSecretIntegrationParameters {
+		delete(response.IntegrationParameters, k)
+	}
+
+	writeFakeJson(w, http.StatusOK, response)
+}
+
+func (f *fakeReportPortal) updateIntegration(w http.ResponseWriter, r *http.Request, projectName, integrationId string) {
+	integration := f.lookupIntegration(w, projectName, integrationId)
+	if integration == nil {
+		return
+	}
+
+	var req rpClient.IntegrationPayload
+	if !readFakeJson(w, r, &req) {
+		return
+	}
+
+	integration.integration.Name = req.Name
+	integration.integration.Enabled = req.Enabled
+	integration.integration.IntegrationParameters = req.IntegrationParameters
+
+	writeFakeJson(w, http.StatusOK, map[string]string{"message": fmt.Sprintf("Integration with ID = '%d' has been successfully updated.", integration.integration.Id)})
+}
+
+func (f *fakeReportPortal) deleteIntegrationById(w http.ResponseWriter, projectName, integrationId string) {
+	if integration := f.lookupIntegration(w, projectName, integrationId); integration != nil {
+		delete(f.integrations, integration.integration.Id)
+		writeFakeJson(w, http.StatusOK, map[string]string{"message": fmt.Sprintf("Integration with ID = '%d' has been successfully deleted.", integration.integration.Id)})
+	}
+}
+
+func (f *fakeReportPortal) createGlobalIntegration(w http.ResponseWriter, r *http.Request, params []string) {
+	f.createIntegration(w, r, "", params[0])
+}
+
+func (f *fakeReportPortal) getGlobalIntegration(w http.ResponseWriter, _ *http.Request, params []string) {
+	f.getIntegration(w, "", params[0])
+}
+
+func (f *fakeReportPortal) updateGlobalIntegration(w http.ResponseWriter, r *http.Request, params []string) {
+	f.updateIntegration(w, r, "", params[0])
+}
+
+func (f *fakeReportPortal) deleteGlobalIntegration(w http.ResponseWriter, _ *http.Request, params []string) {
+	f.deleteIntegrationById(w, "", params[0])
+}
+
+func (f *fakeReportPortal) createProjectIntegration(w http.ResponseWriter, r *http.Request, params []string) {
+	if f.lookupProject(w, params[0]) {
+		f.createIntegration(w, r, params[0], params[1])
+	}
+}
+
+func (f *fakeReportPortal) getProjectIntegration(w http.ResponseWriter, _ *http.Request, params []string) {
+	f.getIntegration(w, params[0], params[1])
+}
+
+func (f *fakeReportPortal) updateProjectIntegration(w http.ResponseWriter, r *http.Request, params []string) {
+	f.updateIntegration(w, r, params[0], params[1])
+}
+
+func (f *fakeReportPortal) deleteProjectIntegration(w http.ResponseWriter, _ *http.Request, params []string) {
+	f.deleteIntegrationById(w, params[0], params[1])
+}
+
+// integrationParameter returns a parameter of the integration as stored by ReportPortal.
+func (f *fakeReportPortal) integrationParameter(id int, key string) interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if integration, ok := f.integrations[id]; ok {
+		return integration.integration.IntegrationParameters[key]
+	}
+	return nil
+}
+
+func (f *fakeReportPortal) hasIntegration(id int) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	_, ok := f.integrations[id]
+	return ok
+}
+
+func (f *fakeReportPortal) removeIntegration(id int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.integrations, id)
+}
+
+// LDAP settings
+
+func (f *fakeReportPortal) getLdapSettings(w http.ResponseWriter, _ *http.Request, _ []string) {
+	if f.ldap == nil {
+		writeFakeError(w, http.StatusNotFound, 40418, "Auth integration 'ldap' not found.")
+		return
+	}
+	// the manager password is never returned
+	settings := *f.ldap
+	settings.ManagerPassword = nil
+	writeFakeJson(w, http.StatusOK, &settings)
+}
+
+func (f *fakeReportPortal) createLdapSettings(w http.ResponseWriter, r *http.Request, _ []string) {
+	var payload rpClient.LdapIntegrationPayload
+	if !readFakeJson(w, r, &payload) {
+		return
+	}
+	if f.ldap != nil {
+		writeFakeError(w, http.StatusConflict, 4091, "Integration with name 'ldap' already exists.")
+		return
+	}
+
+	f.ldap = newFakeLdapSettings(f.newId(), &payload)
+	writeFakeJson(w, http.StatusOK, f.ldap)
+}
+
+// updateLdapSettings replaces every integration parameter, like ReportPortal 5.4.0 does
+func (f *fakeReportPortal) updateLdapSettings(w http.ResponseWriter, r *http.Request, params []string) {
+	id, _ := strconv.Atoi(params[0])
+	if f.ldap == nil || *f.ldap.Id != id {
+		writeFakeError(w, http.StatusNotFound, 40418, fmt.Sprintf("Auth integration '%d' not found.", id))
+		return
+	}
+
+	var payload rpClient.LdapIntegrationPayload
+	if !readFakeJson(w, r, &payload) {
+		return
+	}
+
+	f.ldap = newFakeLdapSettings(id, &payload)
+	writeFakeJson(w, http.StatusOK, f.ldap)
+}
+
+func newFakeLdapSettings(id int, payload *rpClient.LdapIntegrationPayload) *rpClient.LdapSettings {
+	p := payload.IntegrationParameters
+	settings := &rpClient.LdapSettings{Id: &id}
+	settings.LdapAttributes.Enabled = &payload.Enabled
+	settings.LdapAttributes.Url = &p.Url
+	settings.LdapAttributes.BaseDn = &p.BaseDn
+	settings.LdapAttributes.SynchronizationAttributes.Email = &p.Email
+	settings.LdapAttributes.SynchronizationAttributes.FullName = &p.FullName
+	settings.LdapAttributes.SynchronizationAttributes.Photo = &p.Photo
+	settings.UserDnPattern = &p.UserDnPattern
+	settings.UserSearchFilter = &p.UserSearchFilter
+	settings.GroupSearchBase = &p.GroupSearchBase
+	settings.GroupSearchFilter = &p.GroupSearchFilter
+	settings.PasswordEncoderType = &p.PasswordEncoderType
+	settings.PasswordAttribute = &p.PasswordAttribute
+	settings.ManagerDn = &p.ManagerDn
+	settings.ManagerPassword = &p.ManagerPassword
+	return settings
+}
+
+func (f *fakeReportPortal) hasLdapSettings() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.ldap != nil
+}
+
+func (f *fakeReportPortal) ldapSettingsId() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.ldap == nil {
+		return 0
+	}
+	return *f.ldap.Id
+}
+
+func (f *fakeReportPortal) ldapManagerPassword() string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.ldap == nil {
+		return ""
+	}
+	return *f.ldap.ManagerPassword
+}
+
+func (f *fakeReportPortal) removeLdapSettings() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.ldap = nil
+}
+
+// Active Directory settings
+
+func (f *fakeReportPortal) getActiveDirectorySettings(w http.ResponseWriter, _ *http.Request, _ []string) {
+	if f.ad == nil {
+		writeFakeError(w, http.StatusNotFound, 40418, "Auth integration 'ad' not found.")
+		return
+	}
+	writeFakeJson(w, http.StatusOK, f.ad)
+}
+
+func (f *fakeReportPortal) createActiveDirectorySettings(w http.ResponseWriter, r *http.Request, _ []string) {
+	var payload rpClient.ActiveDirectoryIntegrationPayload
+	if !readFakeJson(w, r, &payload) {
+		return
+	}
+	if f.ad != nil {
+		writeFakeError(w, http.StatusConflict, 4091, "Integration with name 'ad' already exists.")
+		return
+	}
+
+	f.ad = newFakeActiveDirectorySettings(f.newId(), &payload)
+	writeFakeJson(w, http.StatusOK, f.ad)
+}
+
+func (f *fakeReportPortal) updateActiveDirectorySettings(w http.ResponseWriter, r *http.Request, params []string) {
+	id, _ := strconv.Atoi(params[0])
+	if f.ad == nil || *f.ad.Id != id {
+		writeFakeError(w, http.StatusNotFound, 40418, fmt.Sprintf("Auth integration '%d' not found.", id))
+		return
+	}
+
+	var payload rpClient.ActiveDirectoryIntegrationPayload
+	if !readFakeJson(w, r, &payload) {
+		return
+	}
+
+	f.ad = newFakeActiveDirectorySettings(id, &payload)
+	writeFakeJson(w, http.StatusOK, f.ad)
+}
+
+func newFakeActiveDirectorySettings(id int, payload *rpClient.ActiveDirectoryIntegrationPayload) *rpClient.ActiveDirectorySettings {
+	p := payload.IntegrationParameters
+	settings := &rpClient.ActiveDirectorySettings{Id: &id, Domain: &p.Domain}
+	settings.LdapAttributes.Enabled = &payload.Enabled
+	settings.LdapAttributes.Url = &p.Url
+	settings.LdapAttributes.BaseDn = &p.BaseDn
+	settings.LdapAttributes.SynchronizationAttributes.Email = &p.Email
+	settings.LdapAttributes.SynchronizationAttributes.FullName = &p.FullName
+	settings.LdapAttributes.SynchronizationAttributes.Photo = &p.Photo
+	return settings
+}
+
+func (f *fakeReportPortal) activeDirectorySettingsId() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.ad == nil {
+		return 0
+	}
+	return *f.ad.Id
+}
+
+// replaceActiveDirectorySettings gives the Active Directory integration a new id, as if it
+// had been deleted and created again outside Terraform.
+func (f *fakeReportPortal) replaceActiveDirectorySettings() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	id := f.newId()
+	f.ad.Id = &id
+}
+
+func (f *fakeReportPortal) removeActiveDirectorySettings() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.ad = nil
+}
+
+// SAML providers
+
+func (f *fakeReportPortal) getSamlProviders(w http.ResponseWriter, _ *http.Request, _ []string) {
+	providers := rpClient.SamlProviders{Providers: make([]rpClient.SamlProvider, 0, len(f.saml))}
+	for _, provider := range f.saml {
+		providers.Providers = append(providers.Providers, *provider)
+	}
+	sort.Slice(providers.Providers, func(i, j int) bool {
+		return providers.Providers[i].Id < providers.Providers[j].Id
+	})
+	writeFakeJson(w, http.StatusOK, providers)
+}
+
+func (f *fakeReportPortal) createSamlProvider(w http.ResponseWriter, r *http.Request, _ []string) {
+	var payload rpClient.SamlIntegrationPayload
+	if !readFakeJson(w, r, &payload) {
+		return
+	}
+	for _, provider := range f.saml {
+		if provider.IdentityProviderName == payload.IntegrationParameters.IdentityProviderName {
+			writeFakeError(w, http.StatusConflict, 4091, fmt.Sprintf("Integration with name '%s' already exists.", provider.IdentityProviderName))
+			return
+		}
+	}
+
+	provider := newFakeSamlProvider(f.newId(), &payload)
+	f.saml[provider.Id] = provider
+	writeFakeJson(w, http.StatusOK, provider)
+}
+
+func (f *fakeReportPortal) updateSamlProvider(w http.ResponseWriter, r *http.Request, params []string) {
+	id, _ := strconv.Atoi(params[0])
+	if f.saml[id] == nil {
+		writeFakeError(w, http.StatusNotFound, 40418, fmt.Sprintf("Auth integration '%d' not found.", id))
+		return
+	}
+
+	var payload rpClient.SamlIntegrationPayload
+	if !readFakeJson(w, r, &payload) {
+		return
+	}
+
+	provider := newFakeSamlProvider(id, &payload)
+	f.saml[id] = provider
+	writeFakeJson(w, http.StatusOK, provider)
+}
+
+func newFakeSamlProvider(id int, payload *rpClient.SamlIntegrationPayload) *rpClient.SamlProvider {
+	p := payload.IntegrationParameters
+	return &rpClient.SamlProvider{
+		Id:                          id,
+		Enabled:                     payload.Enabled,
+		IdentityProviderName:        p.IdentityProviderName,
+		IdentityProviderMetadataUrl: p.IdentityProviderMetadataUrl,
+		IdentityProviderNameId:      p.IdentityProviderNameId,
+		IdentityProviderUrl:         fmt.Sprintf("https://idp.example.com/%s/sso", p.IdentityProviderName),
+		EmailAttribute:              p.EmailAttribute,
+		FirstNameAttribute:          p.FirstNameAttribute,
+		LastNameAttribute:           p.LastNameAttribute,
+		FullNameAttribute:           p.FullNameAttribute,
+	}
+}
+
+func (f *fakeReportPortal) hasSamlProvider(id int) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.saml[id] != nil
+}
+
+func (f *fakeReportPortal) removeSamlProvider(id int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.saml, id)
+}
+
+// Auth integrations
+
+func (f *fakeReportPortal) deleteIntegration(w http.ResponseWriter, _ *http.Request, params []string) {
+	id, _ := strconv.Atoi(params[0])
+	switch {
+	case f.ldap != nil && *f.ldap.Id == id:
+		f.ldap = nil
+	case f.ad != nil && *f.ad.Id == id:
+		f.ad = nil
+	case f.saml[id] != nil:
+		delete(f.saml, id)
+	default:
+		writeFakeError(w, http.StatusNotFound, 40418, fmt.Sprintf("Auth integration '%d' not found.", id))
+		return
+	}
+
+	writeFakeJson(w, http.StatusOK, map[string]string{"message": "Auth settings deleted"})
+}
+
+// OAuth settings
+
+func (f *fakeReportPortal) getOAuthSettings(w http.ResponseWriter, _ *http.Request, params []string) {
+	registration, ok := f.oauth[params[0]]
+	if !ok {
+		writeFakeError(w, http.StatusNotFound, 40413, fmt.Sprintf("Oauth settings with id = %s not found.", params[0]))
+		return
+	}
+	// the client secret is never returned
+	masked := *registration
+	masked.ClientSecret = ""
+	writeFakeJson(w, http.StatusOK, &masked)
+}
+
+func (f *fakeReportPortal) updateOAuthSettings(w http.ResponseWriter, r *http.Request, params []string) {
+	var registration rpClient.OAuthRegistration
+	if !readFakeJson(w, r, &registration) {
+		return
+	}
+
+	registration.Id = params[0]
+	f.oauth[params[0]] = &registration
+	writeFakeJson(w, http.StatusOK, &registration)
+}
+
+func (f *fakeReportPortal) deleteOAuthSettings(w http.ResponseWriter, _ *http.Request, params []string) {
+	if _, ok := f.oauth[params[0]]; !ok {
+		writeFakeError(w, http.StatusNotFound, 40413, fmt.Sprintf("Oauth settings with id = %s not found.", params[0]))
+		return
+	}
+
+	delete(f.oauth, params[0])
+	writeFakeJson(w, http.StatusOK, map[string]string{"message": "Auth settings deleted"})
+}
+
+func (f *fakeReportPortal) oauthSettings(registrationId string) *rpClient.OAuthRegistration {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.oauth[registrationId]
+}
+
+func (f *fakeReportPortal) removeOAuthSettings(registrationId string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.oauth, registrationId)
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// newHttpClient builds the HTTP client used to reach ReportPortal from the
+// transport related attributes of the provider block.
+func newHttpClient(d *schema.ResourceData) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	tlsConfig, err := getTlsConfig(d)
+	if err != nil {
+		return nil, err
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	if proxyUrl := d.Get("proxy_url").(string); proxyUrl != "" {
+		u, err := url.Parse(proxyUrl)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy_url %q: %w", proxyUrl, err)
+		}
+		transport.Proxy = http.ProxyURL(u)
+	}
+
+	return &http.Client{
+		Timeout:   time.Duration(d.Get("request_timeout").(int)) * time.Second,
+		Transport: transport,
+	}, nil
+}
+
+func getTlsConfig(d *schema.ResourceData) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: d.Get("insecure_skip_verify").(bool),
+	}
+
+	caCert := []byte(d.Get("ca_cert_pem").(string))
+	if caCertFile := d.Get("ca_cert_file").(string); caCertFile != "" {
+		content, err := ioutil.ReadFile(caCertFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read ca_cert_file: %w", err)
+		}
+		caCert = content
+	}
+
+	if len(caCert) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no valid PEM certificate found in the configured CA bundle")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	return tlsConfig, nil
+}
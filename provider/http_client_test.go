@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"encoding/pem"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func testHttpClient(t *testing.T, raw map[string]interface{}) (*http.Client, error) {
+	raw["host"] = "https://reportportal.example.com"
+	return newHttpClient(schema.TestResourceDataRaw(t, Provider().Schema, raw))
+}
+
+func testTLSServer(t *testing.T) (*httptest.Server, string) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	caPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	return server, string(caPem)
+}
+
+func TestNewHttpClient_tls(t *testing.T) {
+	server, caPem := testTLSServer(t)
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := ioutil.WriteFile(caFile, []byte(caPem), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name      string
+		raw       map[string]interface{}
+		reachable bool
+	}{
+		{"system roots only", map[string]interface{}{}, false},
+		{"ca_cert_pem", map[string]interface{}{"ca_cert_pem": caPem}, true},
+		{"ca_cert_file", map[string]interface{}{"ca_cert_file": caFile}, true},
+		{"insecure_skip_verify", map[string]interface{}{"insecure_skip_verify": true}, true},
+	}
+
+	for _, c := range cases {
+		client, err := testHttpClient(t, c.raw)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", c.name, err)
+		}
+
+		resp, err := client.Get(server.URL)
+		if err == nil {
+			resp.Body.Close()
+		}
+		if (err == nil) != c.reachable {
+			t.Errorf("%s: expected the TLS server to be reachable: %t, got error %v", c.name, c.reachable, err)
+		}
+	}
+}
+
+func TestNewHttpClient_invalidCaBundle(t *testing.T) {
+	cases := []struct {
+		name string
+		raw  map[string]interface{}
+	}{
+		{"invalid PEM", map[string]interface{}{"ca_cert_pem": "not a certificate"}},
+		{"missing file", map[string]interface{}{"ca_cert_file": filepath.Join(t.TempDir(), "missing.pem")}},
+	}
+
+	for _, c := range cases {
+		if _, err := testHttpClient(t, c.raw); err == nil {
+			t.Errorf("%s: expected an error", c.name)
+		}
+	}
+}
+
+func TestNewHttpClient_proxy(t *testing.T) {
+	var proxiedHost string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxiedHost = r.URL.Host
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(proxy.Close)
+
+	client, err := testHttpClient(t, map[string]interface{}{"proxy_url": proxy.URL})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp, err := client.Get("http://reportportal.invalid/api/v1/project/list")
+	if err != nil {
+		t.Fatalf("request through the proxy failed: %s", err)
+	}
+	resp.Body.Close()
+
+	if proxiedHost != "reportportal.invalid" {
+		t.Errorf("expected the request to go through the proxy, proxy received host %q", proxiedHost)
+	}
+
+	if _, err = testHttpClient(t, map[string]interface{}{"proxy_url": "://invalid"}); err == nil {
+		t.Error("expected an error for an invalid proxy_url")
+	}
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strconv"
+	"strings"
+)
+
+// parseProjectScopedId splits an import ID in the <project_name>/<id> format.
+func parseProjectScopedId(importId string) (string, int, error) {
+	idx := strings.LastIndex(importId, "/")
+	if idx <= 0 || idx == len(importId)-1 {
+		return "", 0, fmt.Errorf("unexpected import ID %q, expected <project_name>/<id>", importId)
+	}
+
+	id, err := strconv.Atoi(importId[idx+1:])
+	if err != nil {
+		return "", 0, fmt.Errorf("unexpected import ID %q, the id must be numeric: %w", importId, err)
+	}
+
+	return importId[:idx], id, nil
+}
+
+// resourceProjectScopedImport handles resources identified by project name and numeric ID,
+// leaving the remaining attributes to the resource Read function.
+func resourceProjectScopedImport(_ context.Context, data *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	projectName, id, err := parseProjectScopedId(data.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	data.SetId(strconv.Itoa(id))
+	if err = data.Set("project_name", projectName); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{data}, nil
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Integration parameters are free-form JSON, ReportPortal may return numbers and
+// booleans as strings depending on how the integration was saved.
+
+func integrationString(params map[string]interface{}, key string) string {
+	return toIntegrationString(params[key])
+}
+
+func toIntegrationString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+func integrationInt(params map[string]interface{}, key string) int {
+	switch v := params[key].(type) {
+	case float64:
+		return int(v)
+	case string:
+		i, _ := strconv.Atoi(v)
+		return i
+	default:
+		return 0
+	}
+}
+
+func integrationBool(params map[string]interface{}, key string) bool {
+	switch v := params[key].(type) {
+	case bool:
+		return v
+	case string:
+		b, _ := strconv.ParseBool(v)
+		return b
+	default:
+		return false
+	}
+}
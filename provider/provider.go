@@ -2,59 +2,218 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	rpClient "github.com/rmalveis/report-portal-client-go/client"
+	"os"
+	"time"
 )
 
 func Provider() *schema.Provider {
 	return &schema.Provider{
 		Schema: map[string]*schema.Schema{
+			// The credentials fall back to the environment in providerConfigure rather than through
+			// DefaultFunc, so credentials set in the provider block take precedence over the environment
 			"username": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"api_token"},
 			},
 			"password": {
-				Type:      schema.TypeString,
-				Sensitive: true,
-				Required:  true,
+				Type:          schema.TypeString,
+				Sensitive:     true,
+				Optional:      true,
+				ConflictsWith: []string{"api_token"},
+			},
+			"api_token": {
+				Type:          schema.TypeString,
+				Sensitive:     true,
+				Optional:      true,
+				ConflictsWith: []string{"username", "password"},
 			},
 			"host": {
-
-				Type:     schema.TypeString,
-				Required: true,
+				Type:        schema.TypeString,
+				Required:    true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"REPORTPORTAL_HOST"}, nil),
+			},
+			"request_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"ca_cert_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.MultiEnvDefaultFunc([]string{"REPORTPORTAL_CA_CERT_FILE"}, nil),
+				ConflictsWith: []string{"ca_cert_pem"},
+			},
+			"ca_cert_pem": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"ca_cert_file"},
+			},
+			"insecure_skip_verify": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"retry_wait_min": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"retry_wait_max": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"proxy_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"REPORTPORTAL_PROXY_URL"}, nil),
 			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"reportportal_project":            resourceProject(),
-			"reportportal_dashboard":          resourceDashboard(),
-			"reportportal_auth_ldap_settings": resourceAuthLdapSettings(),
-			"reportportal_filter":             resourceFilter(),
-			"reportportal_widget":             resourceWidget(),
+			"reportportal_project":                        resourceProject(),
+			"reportportal_dashboard":                      resourceDashboard(),
+			"reportportal_auth_ldap_settings":             resourceAuthLdapSettings(),
+			"reportportal_filter":                         resourceFilter(),
+			"reportportal_widget":                         resourceWidget(),
+			"reportportal_project_defect_type":            resourceProjectDefectType(),
+			"reportportal_project_user":                   resourceProjectUser(),
+			"reportportal_project_members":                resourceProjectMembers(),
+			"reportportal_user":                           resourceUser(),
+			"reportportal_api_key":                        resourceApiKey(),
+			"reportportal_project_notifications":          resourceProjectNotifications(),
+			"reportportal_email_server_integration":       resourceEmailServerIntegration(),
+			"reportportal_jira_integration":               resourceJiraIntegration(),
+			"reportportal_project_analyzer_settings":      resourceProjectAnalyzerSettings(),
+			"reportportal_pattern_rule":                   resourcePatternRule(),
+			"reportportal_auth_active_directory_settings": resourceAuthActiveDirectorySettings(),
+			"reportportal_auth_saml_provider":             resourceAuthSamlProvider(),
+			"reportportal_auth_github_settings":           resourceAuthGithubSettings(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"reportportal_projects":                  dataSourceProjects(),
-			"reportportal_auth_ldap_settings":        dataSourceAuthLdapSettings(),
-			"reportportal_widget_by_project_details": dataSourceWidgetsByProjectAndId(),
-			"reportportal_filters":                   dataSourceFilters(),
+			"reportportal_projects":                       dataSourceProjects(),
+			"reportportal_auth_ldap_settings":             dataSourceAuthLdapSettings(),
+			"reportportal_widget_by_project_details":      dataSourceWidgetsByProjectAndId(),
+			"reportportal_filters":                        dataSourceFilters(),
+			"reportportal_project_defect_types":           dataSourceProjectDefectTypes(),
+			"reportportal_users":                          dataSourceUsers(),
+			"reportportal_auth_active_directory_settings": dataSourceAuthActiveDirectorySettings(),
+			"reportportal_auth_saml_providers":            dataSourceAuthSamlProviders(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
 }
 
 func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-	username := d.Get("username").(string)
-	password := d.Get("password").(string)
+	username, password, apiToken := resolveCredentials(
+		d.Get("username").(string), d.Get("password").(string), d.Get("api_token").(string), os.Getenv)
 	host := d.Get("host").(string)
 
+	if diags := validateCredentials(username, password, apiToken); diags.HasError() {
+		return nil, diags
+	}
+
+	if diags := validateRetryWait(d.Get("retry_wait_min").(int), d.Get("retry_wait_max").(int)); diags.HasError() {
+		return nil, diags
+	}
+
+	httpClient, err := newHttpClient(d)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
 	c, err := rpClient.NewClient(&rpClient.ReportPortalClientConfig{
-		Username: username,
-		Password: password,
-		Host:     host,
-	}, nil)
+		Username:     username,
+		Password:     password,
+		ApiToken:     apiToken,
+		Host:         host,
+		MaxRetries:   d.Get("max_retries").(int),
+		RetryWaitMin: time.Duration(d.Get("retry_wait_min").(int)) * time.Second,
+		RetryWaitMax: time.Duration(d.Get("retry_wait_max").(int)) * time.Second,
+	}, httpClient)
 	if err != nil {
 		return nil, diag.FromErr(err)
 	}
 
 	return c, nil
 }
+
+// resolveCredentials completes the credentials of the provider block with the REPORTPORTAL_*
+// environment variables. The environment is only used for the credential kind the provider block
+// did not choose: an api_token in the block ignores REPORTPORTAL_USERNAME/REPORTPORTAL_PASSWORD and
+// username/password in the block ignore REPORTPORTAL_API_TOKEN.
+func resolveCredentials(username, password, apiToken string, getenv func(string) string) (string, string, string) {
+	if apiToken != "" {
+		return "", "", apiToken
+	}
+
+	if username == "" && password == "" {
+		apiToken = getenv("REPORTPORTAL_API_TOKEN")
+		if apiToken == "" {
+			apiToken = getenv("REPORTPORTAL_TOKEN")
+		}
+	}
+	if username == "" {
+		username = getenv("REPORTPORTAL_USERNAME")
+	}
+	if password == "" {
+		password = getenv("REPORTPORTAL_PASSWORD")
+	}
+
+	return username, password, apiToken
+}
+
+// validateRetryWait rejects a retry policy whose maximum wait is shorter than its minimum wait.
+func validateRetryWait(waitMin, waitMax int) diag.Diagnostics {
+	if waitMax < waitMin {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Invalid ReportPortal retry policy",
+			Detail:   fmt.Sprintf("retry_wait_max (%ds) must not be shorter than retry_wait_min (%ds).", waitMax, waitMin),
+		}}
+	}
+	return nil
+}
+
+// validateCredentials ensures exactly one complete credential set was provided, either
+// through the provider block or the REPORTPORTAL_* environment variables.
+func validateCredentials(username, password, apiToken string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	hasUserCredentials := username != "" || password != ""
+
+	switch {
+	case apiToken != "" && hasUserCredentials:
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Conflicting ReportPortal credentials",
+			Detail: "Both api_token and username/password were provided. Configure only one of them, " +
+				"either in the provider block or through the REPORTPORTAL_API_TOKEN or " +
+				"REPORTPORTAL_USERNAME/REPORTPORTAL_PASSWORD environment variables.",
+		})
+	case apiToken == "" && (username == "" || password == ""):
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Missing ReportPortal credentials",
+			Detail: "The provider requires either api_token or both username and password. " +
+				"They can be set in the provider block or through the REPORTPORTAL_API_TOKEN or " +
+				"REPORTPORTAL_USERNAME/REPORTPORTAL_PASSWORD environment variables.",
+		})
+	}
+
+	return diags
+}
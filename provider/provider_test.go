@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"os"
+	"strconv"
+	"testing"
+)
+
+var testAccProviderFactories = map[string]func() (*schema.Provider, error){
+	"reportportal": func() (*schema.Provider, error) {
+		return Provider(), nil
+	},
+}
+
+func TestProvider(t *testing.T) {
+	if err := Provider().InternalValidate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestValidateCredentials(t *testing.T) {
+	cases := []struct {
+		username, password, apiToken string
+		expectError                  bool
+	}{
+		{"user", "pass", "", false},
+		{"", "", "token", false},
+		{"user", "", "", true},
+		{"", "pass", "", true},
+		{"", "", "", true},
+		{"user", "pass", "token", true},
+	}
+
+	for _, c := range cases {
+		diags := validateCredentials(c.username, c.password, c.apiToken)
+		if diags.HasError() != c.expectError {
+			t.Errorf("validateCredentials(%q, %q, %q): expected error %t, got %v", c.username, c.password, c.apiToken, c.expectError, diags)
+		}
+	}
+}
+
+func TestValidateRetryWait(t *testing.T) {
+	if diags := validateRetryWait(1, 30); diags.HasError() {
+		t.Errorf("unexpected error for a valid retry policy: %v", diags)
+	}
+	if diags := validateRetryWait(5, 5); diags.HasError() {
+		t.Errorf("unexpected error for equal waits: %v", diags)
+	}
+	if diags := validateRetryWait(10, 5); !diags.HasError() {
+		t.Error("expected an error when retry_wait_max is shorter than retry_wait_min")
+	}
+
+	for _, key := range []string{"retry_wait_min", "retry_wait_max"} {
+		if _, errs := Provider().Schema[key].ValidateFunc(0, key); len(errs) == 0 {
+			t.Errorf("expected %s = 0 to be rejected", key)
+		}
+	}
+}
+
+func TestResolveCredentials(t *testing.T) {
+	env := map[string]string{
+		"REPORTPORTAL_USERNAME":  "env-user",
+		"REPORTPORTAL_PASSWORD":  "env-pass",
+		"REPORTPORTAL_API_TOKEN": "env-token",
+	}
+	getenv := func(key string) string { return env[key] }
+	noEnv := func(string) string { return "" }
+
+	cases := []struct {
+		name                                  string
+		username, password, apiToken          string
+		getenv                                func(string) string
+		wantUsername, wantPassword, wantToken string
+	}{
+		{"token in config overrides env user", "", "", "token", getenv, "", "", "token"},
+		{"user in config overrides env token", "user", "pass", "", getenv, "user", "pass", ""},
+		{"password completed from env", "user", "", "", getenv, "user", "env-pass", ""},
+		{"nothing in config", "", "", "", noEnv, "", "", ""},
+		{"only env token", "", "", "", func(key string) string {
+			if key == "REPORTPORTAL_TOKEN" {
+				return "legacy-token"
+			}
+			return ""
+		}, "", "", "legacy-token"},
+	}
+
+	for _, c := range cases {
+		username, password, apiToken := resolveCredentials(c.username, c.password, c.apiToken, c.getenv)
+		if username != c.wantUsername || password != c.wantPassword || apiToken != c.wantToken {
+			t.Errorf("%s: got (%q, %q, %q), expected (%q, %q, %q)", c.name,
+				username, password, apiToken, c.wantUsername, c.wantPassword, c.wantToken)
+		}
+		if c.wantToken != "" || c.wantUsername != "" {
+			if diags := validateCredentials(username, password, apiToken); diags.HasError() {
+				t.Errorf("%s: unexpected credential error %v", c.name, diags)
+			}
+		}
+	}
+}
+
+func TestAccProvider_apiToken(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	// credentials of the other kind in the environment must not conflict with the provider block
+	for _, key := range []string{"REPORTPORTAL_USERNAME", "REPORTPORTAL_PASSWORD"} {
+		key := key
+		previous, set := os.LookupEnv(key)
+		os.Setenv(key, "env-"+key)
+		t.Cleanup(func() {
+			if set {
+				os.Setenv(key, previous)
+			} else {
+				os.Unsetenv(key)
+			}
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "reportportal" {
+  host        = %q
+  api_token   = %q
+  max_retries = 0
+}
+
+data "reportportal_projects" "all" {}
+`, fake.URL(), fakeApiToken),
+				Check: func(*terraform.State) error {
+					if grants, _, unauthorized := fake.tokenStats(); grants != 0 || unauthorized != 0 {
+						return fmt.Errorf("expected the API token to be used as is, got %d token grants and %d rejected requests", grants, unauthorized)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+// testAccProviderConfig points the provider to the given fake ReportPortal instance.
+func testAccProviderConfig(fake *fakeReportPortal) string {
+	return fmt.Sprintf(`
+provider "reportportal" {
+  host        = %q
+  username    = %q
+  password    = %q
+  max_retries = 0
+}
+`, fake.URL(), fakeUsername, fakePassword)
+}
+
+// testAccResourceId reads the numeric ID of a resource from the Terraform state.
+func testAccResourceId(s *terraform.State, resourceName string) (int, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return 0, fmt.Errorf("resource %s not found in state", resourceName)
+	}
+
+	return strconv.Atoi(rs.Primary.ID)
+}
+
+// testAccProjectScopedImportId builds the <project_name>/<id> import ID of a resource.
+func testAccProjectScopedImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found in state", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["project_name"], rs.Primary.ID), nil
+	}
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	rpClient "github.com/rmalveis/report-portal-client-go/client"
+	"log"
+	"strconv"
+)
+
+// resourceApiKey has no update: every change replaces the key, which is how keys are rotated.
+func resourceApiKey() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceApiKeyCreate,
+		ReadContext:   resourceApiKeyRead,
+		DeleteContext: resourceApiKeyDelete,
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"api_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceApiKeyCreate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	userId := data.Get("user_id").(int)
+	name := data.Get("name").(string)
+
+	key, err := c.CreateApiKey(userId, name)
+	if err != nil {
+		if rpClient.IsConflict(err) {
+			return diag.Errorf("API key %q already exists for user %d: %s", name, userId, err)
+		}
+		return diag.FromErr(err)
+	}
+
+	data.SetId(strconv.Itoa(key.Id))
+
+	// The secret is only returned on creation, so it is kept from here on
+	data.Set("api_key", key.ApiKey)
+
+	return resourceApiKeyRead(ctx, data, i)
+}
+
+func resourceApiKeyRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	userId := data.Get("user_id").(int)
+	keyId, err := strconv.Atoi(data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	key, err := c.GetApiKey(userId, keyId)
+	if err != nil {
+		if rpClient.IsNotFound(err) {
+			log.Printf("[WARN] ReportPortal API key %d of user %d not found, removing it from state", keyId, userId)
+			data.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	data.Set("name", key.Name)
+
+	var diags diag.Diagnostics
+	return diags
+}
+
+func resourceApiKeyDelete(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	userId := data.Get("user_id").(int)
+	keyId, err := strconv.Atoi(data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = c.DeleteApiKey(userId, keyId)
+	if err != nil && !rpClient.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"regexp"
+	"testing"
+)
+
+func TestAccResourceApiKey_basic(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	var firstId int
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckApiKeyDestroy(fake, "reportportal_api_key.test"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceApiKeyConfig(fake, "nightly"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApiKeyExists(fake, "reportportal_api_key.test"),
+					resource.TestCheckResourceAttrPair("reportportal_api_key.test", "user_id", "reportportal_user.test", "id"),
+					resource.TestCheckResourceAttr("reportportal_api_key.test", "name", "nightly"),
+					resource.TestMatchResourceAttr("reportportal_api_key.test", "api_key", regexp.MustCompile(`^nightly_`)),
+					func(s *terraform.State) (err error) {
+						firstId, err = testAccResourceId(s, "reportportal_api_key.test")
+						return err
+					},
+				),
+			},
+			{
+				Config: testAccResourceApiKeyConfig(fake, "nightly-rotated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApiKeyExists(fake, "reportportal_api_key.test"),
+					resource.TestMatchResourceAttr("reportportal_api_key.test", "api_key", regexp.MustCompile(`^nightly-rotated_`)),
+					func(s *terraform.State) error {
+						if fake.hasApiKey(firstId) {
+							return fmt.Errorf("replaced API key %d was not revoked", firstId)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceApiKey_disappears(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckApiKeyDestroy(fake, "reportportal_api_key.test"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceApiKeyConfig(fake, "nightly"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApiKeyExists(fake, "reportportal_api_key.test"),
+					func(s *terraform.State) error {
+						id, err := testAccResourceId(s, "reportportal_api_key.test")
+						if err != nil {
+							return err
+						}
+						fake.removeApiKey(id)
+						return nil
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccResourceApiKeyConfig(fake, "nightly"),
+				Check:  testAccCheckApiKeyExists(fake, "reportportal_api_key.test"),
+			},
+		},
+	})
+}
+
+func testAccCheckApiKeyExists(fake *fakeReportPortal, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id, err := testAccResourceId(s, resourceName)
+		if err != nil {
+			return err
+		}
+		if !fake.hasApiKey(id) {
+			return fmt.Errorf("API key %d not found", id)
+		}
+		return nil
+	}
+}
+
+func testAccCheckApiKeyDestroy(fake *fakeReportPortal, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id, err := testAccResourceId(s, resourceName)
+		if err != nil {
+			return nil
+		}
+		if fake.hasApiKey(id) {
+			return fmt.Errorf("API key %d still exists", id)
+		}
+		return nil
+	}
+}
+
+func testAccResourceApiKeyConfig(fake *fakeReportPortal, name string) string {
+	return testAccResourceUserConfig(fake, "Test Runner", "USER") + fmt.Sprintf(`
+resource "reportportal_api_key" "test" {
+  user_id = reportportal_user.test.id
+  name    = %q
+}
+`, name)
+}
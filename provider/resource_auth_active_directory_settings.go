@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	rpClient "github.com/rmalveis/report-portal-client-go/client"
+	"log"
+	"strconv"
+)
+
+func resourceAuthActiveDirectorySettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAuthActiveDirectorySettingsCreate,
+		ReadContext:   resourceAuthActiveDirectorySettingsRead,
+		UpdateContext: resourceAuthActiveDirectorySettingsUpdate,
+		DeleteContext: resourceAuthActiveDirectorySettingsDelete,
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ldap_attrs_enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"ldap_attrs_url": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ldap_attrs_base_dn": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ldap_attrs_sync_email": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ldap_attrs_sync_fullname": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ldap_attrs_sync_photo": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceAuthActiveDirectorySettingsCreate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(*rpClient.Client)
+
+	savedSettings, err := client.CreateAuthActiveDirectorySettings(getActiveDirectorySettingsFromData(data))
+	if err != nil {
+		if rpClient.IsConflict(err) {
+			return diag.Errorf("Active Directory settings already exist, remove them before managing them with terraform: %s", err)
+		}
+		return diag.FromErr(err)
+	}
+
+	data.SetId(strconv.Itoa(*savedSettings.Id))
+
+	return resourceAuthActiveDirectorySettingsRead(ctx, data, i)
+}
+
+func resourceAuthActiveDirectorySettingsRead(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := i.(*rpClient.Client)
+
+	adSettings, err := client.ReadActiveDirectoryAuthSettings()
+	if err != nil {
+		if rpClient.IsNotFound(err) {
+			log.Printf("[WARN] ReportPortal Active Directory settings %s not found, removing them from state", data.Id())
+			data.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	// ReportPortal only keeps one Active Directory integration, another id means it was replaced
+	if adSettings.Id == nil || strconv.Itoa(*adSettings.Id) != data.Id() {
+		log.Printf("[WARN] ReportPortal Active Directory settings %s were replaced, removing them from state", data.Id())
+		data.SetId("")
+		return diags
+	}
+
+	for key, value := range flattenActiveDirectorySettings(adSettings) {
+		if err = data.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceAuthActiveDirectorySettingsUpdate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(*rpClient.Client)
+
+	integrationId, err := strconv.Atoi(data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.UpdateAuthActiveDirectorySettings(&integrationId, getActiveDirectorySettingsFromData(data))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceAuthActiveDirectorySettingsRead(ctx, data, i)
+}
+
+func resourceAuthActiveDirectorySettingsDelete(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := i.(*rpClient.Client)
+
+	integrationId, err := strconv.Atoi(data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = client.DeleteIntegration(&integrationId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId("")
+
+	return diags
+}
+
+func getActiveDirectorySettingsFromData(data *schema.ResourceData) *rpClient.ActiveDirectoryIntegrationParameters {
+	return &rpClient.ActiveDirectoryIntegrationParameters{
+		Enabled:  data.Get("ldap_attrs_enabled").(bool),
+		Domain:   data.Get("domain").(string),
+		Url:      data.Get("ldap_attrs_url").(string),
+		BaseDn:   data.Get("ldap_attrs_base_dn").(string),
+		Email:    data.Get("ldap_attrs_sync_email").(string),
+		FullName: data.Get("ldap_attrs_sync_fullname").(string),
+		Photo:    data.Get("ldap_attrs_sync_photo").(string),
+	}
+}
+
+// flattenActiveDirectorySettings maps the settings to the attributes shared by the resource and the data source
+func flattenActiveDirectorySettings(settings *rpClient.ActiveDirectorySettings) map[string]interface{} {
+	return map[string]interface{}{
+		"domain":                   settings.Domain,
+		"ldap_attrs_enabled":       settings.LdapAttributes.Enabled,
+		"ldap_attrs_url":           settings.LdapAttributes.Url,
+		"ldap_attrs_base_dn":       settings.LdapAttributes.BaseDn,
+		"ldap_attrs_sync_email":    settings.LdapAttributes.SynchronizationAttributes.Email,
+		"ldap_attrs_sync_fullname": settings.LdapAttributes.SynchronizationAttributes.FullName,
+		"ldap_attrs_sync_photo":    settings.LdapAttributes.SynchronizationAttributes.Photo,
+	}
+}
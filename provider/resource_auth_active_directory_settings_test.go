@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"regexp"
+	"testing"
+)
+
+func TestAccResourceAuthActiveDirectorySettings_basic(t *testing.T) {
+	fake := newFakeReportPortal(t)
+	var adId int
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAuthActiveDirectorySettingsDestroy(fake),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAuthActiveDirectorySettingsConfig(fake, "dc=example,dc=com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthActiveDirectorySettingsExists(fake),
+					resource.TestCheckResourceAttr("reportportal_auth_active_directory_settings.test", "domain", "example.com"),
+					resource.TestCheckResourceAttr("reportportal_auth_active_directory_settings.test", "ldap_attrs_url", "ldap://ad.example.com:389"),
+					resource.TestCheckResourceAttr("reportportal_auth_active_directory_settings.test", "ldap_attrs_base_dn", "dc=example,dc=com"),
+					func(*terraform.State) error {
+						adId = fake.activeDirectorySettingsId()
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccResourceAuthActiveDirectorySettingsConfig(fake, "ou=staff,dc=example,dc=com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("reportportal_auth_active_directory_settings.test", "ldap_attrs_base_dn", "ou=staff,dc=example,dc=com"),
+					func(*terraform.State) error {
+						if id := fake.activeDirectorySettingsId(); id != adId {
+							return fmt.Errorf("Active Directory settings were recreated: id changed from %d to %d", adId, id)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceAuthActiveDirectorySettings_disappears(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAuthActiveDirectorySettingsDestroy(fake),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAuthActiveDirectorySettingsConfig(fake, "dc=example,dc=com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthActiveDirectorySettingsExists(fake),
+					func(*terraform.State) error {
+						fake.removeActiveDirectorySettings()
+						return nil
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccResourceAuthActiveDirectorySettingsConfig(fake, "dc=example,dc=com"),
+				Check:  testAccCheckAuthActiveDirectorySettingsExists(fake),
+			},
+		},
+	})
+}
+
+func TestAccResourceAuthActiveDirectorySettings_replaced(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAuthActiveDirectorySettingsConfig(fake, "dc=example,dc=com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthActiveDirectorySettingsExists(fake),
+					func(*terraform.State) error {
+						fake.replaceActiveDirectorySettings()
+						return nil
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// the integration created outside Terraform is not adopted
+				Config:      testAccResourceAuthActiveDirectorySettingsConfig(fake, "dc=example,dc=com"),
+				ExpectError: regexp.MustCompile(`Active Directory settings already exist`),
+			},
+		},
+	})
+}
+
+func testAccCheckAuthActiveDirectorySettingsExists(fake *fakeReportPortal) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if fake.activeDirectorySettingsId() == 0 {
+			return fmt.Errorf("Active Directory settings not found")
+		}
+		return nil
+	}
+}
+
+func testAccCheckAuthActiveDirectorySettingsDestroy(fake *fakeReportPortal) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if fake.activeDirectorySettingsId() != 0 {
+			return fmt.Errorf("Active Directory settings still exist")
+		}
+		return nil
+	}
+}
+
+func testAccResourceAuthActiveDirectorySettingsConfig(fake *fakeReportPortal, baseDn string) string {
+	return testAccProviderConfig(fake) + fmt.Sprintf(`
+resource "reportportal_auth_active_directory_settings" "test" {
+  domain                   = "example.com"
+  ldap_attrs_enabled       = true
+  ldap_attrs_url           = "ldap://ad.example.com:389"
+  ldap_attrs_base_dn       = %q
+  ldap_attrs_sync_email    = "mail"
+  ldap_attrs_sync_fullname = "displayName"
+}
+`, baseDn)
+}
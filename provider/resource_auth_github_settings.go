@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	rpClient "github.com/rmalveis/report-portal-client-go/client"
+	"log"
+	"sort"
+	"strings"
+)
+
+func resourceAuthGithubSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAuthGithubSettingsCreate,
+		ReadContext:   resourceAuthGithubSettingsRead,
+		UpdateContext: resourceAuthGithubSettingsUpdate,
+		DeleteContext: resourceAuthGithubSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAuthGithubSettingsImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"client_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"client_secret": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"organizations": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceAuthGithubSettingsCreate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(*rpClient.Client)
+
+	// the registration is created or replaced by the same request, so an existing one must be imported instead
+	_, err := client.GetOAuthSettings(rpClient.OAuthRegistrationGithub)
+	if err == nil {
+		return diag.Errorf("GitHub OAuth settings already exist, use terraform import to manage them")
+	}
+	if !rpClient.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.UpdateOAuthSettings(rpClient.OAuthRegistrationGithub, getGithubSettingsFromData(data))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(rpClient.OAuthRegistrationGithub)
+
+	return resourceAuthGithubSettingsRead(ctx, data, i)
+}
+
+func resourceAuthGithubSettingsRead(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := i.(*rpClient.Client)
+
+	registration, err := client.GetOAuthSettings(data.Id())
+	if err != nil {
+		if rpClient.IsNotFound(err) {
+			log.Printf("[WARN] ReportPortal OAuth settings %s not found, removing them from state", data.Id())
+			data.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	if err = data.Set("client_id", registration.ClientId); err != nil {
+		return diag.FromErr(err)
+	}
+	// the secret may not be returned in clear, the configured one is kept
+	if registration.ClientSecret != "" {
+		if err = data.Set("client_secret", registration.ClientSecret); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err = data.Set("organizations", registration.Organizations()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceAuthGithubSettingsUpdate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(*rpClient.Client)
+
+	_, err := client.UpdateOAuthSettings(data.Id(), getGithubSettingsFromData(data))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceAuthGithubSettingsRead(ctx, data, i)
+}
+
+func resourceAuthGithubSettingsDelete(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := i.(*rpClient.Client)
+
+	err := client.DeleteOAuthSettings(data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId("")
+
+	return diags
+}
+
+func getGithubSettingsFromData(data *schema.ResourceData) *rpClient.OAuthRegistration {
+	registration := &rpClient.OAuthRegistration{
+		Id:           rpClient.OAuthRegistrationGithub,
+		ClientId:     data.Get("client_id").(string),
+		ClientSecret: data.Get("client_secret").(string),
+	}
+
+	organizations := toStringSlice(data.Get("organizations").(*schema.Set).List())
+	if len(organizations) > 0 {
+		sort.Strings(organizations)
+		registration.Restrictions = map[string]string{
+			rpClient.OAuthRestrictionOrganizations: strings.Join(organizations, ","),
+		}
+	}
+
+	return registration
+}
+
+// resourceAuthGithubSettingsImport only accepts the "github" ID, ReportPortal keeps a single GitHub registration.
+func resourceAuthGithubSettingsImport(_ context.Context, data *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	if data.Id() != rpClient.OAuthRegistrationGithub {
+		return nil, fmt.Errorf("unexpected ID %q, the GitHub settings are imported with the ID %q", data.Id(), rpClient.OAuthRegistrationGithub)
+	}
+
+	return []*schema.ResourceData{data}, nil
+}
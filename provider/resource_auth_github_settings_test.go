@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	rpClient "github.com/rmalveis/report-portal-client-go/client"
+	"regexp"
+	"testing"
+)
+
+func TestAccResourceAuthGithubSettings_basic(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAuthGithubSettingsDestroy(fake),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAuthGithubSettingsConfig(fake, "secret", `["acme"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthGithubSettings(fake, "secret", "acme"),
+					resource.TestCheckResourceAttr("reportportal_auth_github_settings.test", "id", "github"),
+					resource.TestCheckResourceAttr("reportportal_auth_github_settings.test", "client_id", "Iv1.0123456789abcdef"),
+					resource.TestCheckResourceAttr("reportportal_auth_github_settings.test", "organizations.#", "1"),
+				),
+			},
+			{
+				Config: testAccResourceAuthGithubSettingsConfig(fake, "rotated", `["acme", "acme-labs"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthGithubSettings(fake, "rotated", "acme,acme-labs"),
+					resource.TestCheckResourceAttr("reportportal_auth_github_settings.test", "organizations.#", "2"),
+				),
+			},
+			{
+				ResourceName:            "reportportal_auth_github_settings.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"client_secret"},
+			},
+			{
+				ResourceName:  "reportportal_auth_github_settings.test",
+				ImportState:   true,
+				ImportStateId: "gitlab",
+				ExpectError:   regexp.MustCompile(`unexpected ID "gitlab", the GitHub settings are imported with the ID "github"`),
+			},
+		},
+	})
+}
+
+func TestAccResourceAuthGithubSettings_disappears(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAuthGithubSettingsDestroy(fake),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAuthGithubSettingsConfig(fake, "secret", `[]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthGithubSettings(fake, "secret", ""),
+					func(*terraform.State) error {
+						fake.removeOAuthSettings(rpClient.OAuthRegistrationGithub)
+						return nil
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccResourceAuthGithubSettingsConfig(fake, "secret", `[]`),
+				Check:  testAccCheckAuthGithubSettings(fake, "secret", ""),
+			},
+		},
+	})
+}
+
+func testAccCheckAuthGithubSettings(fake *fakeReportPortal, clientSecret, organizations string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		registration := fake.oauthSettings(rpClient.OAuthRegistrationGithub)
+		if registration == nil {
+			return fmt.Errorf("GitHub OAuth settings not found")
+		}
+		if registration.ClientSecret != clientSecret {
+			return fmt.Errorf("expected client secret %q, got %q", clientSecret, registration.ClientSecret)
+		}
+		if got := registration.Restrictions[rpClient.OAuthRestrictionOrganizations]; got != organizations {
+			return fmt.Errorf("expected organizations %q, got %q", organizations, got)
+		}
+		return nil
+	}
+}
+
+func testAccCheckAuthGithubSettingsDestroy(fake *fakeReportPortal) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if fake.oauthSettings(rpClient.OAuthRegistrationGithub) != nil {
+			return fmt.Errorf("GitHub OAuth settings still exist")
+		}
+		return nil
+	}
+}
+
+func testAccResourceAuthGithubSettingsConfig(fake *fakeReportPortal, clientSecret, organizations string) string {
+	return testAccProviderConfig(fake) + fmt.Sprintf(`
+resource "reportportal_auth_github_settings" "test" {
+  client_id     = "Iv1.0123456789abcdef"
+  client_secret = %q
+  organizations = %s
+}
+`, clientSecret, organizations)
+}
@@ -6,95 +6,79 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	rpClient "github.com/rmalveis/report-portal-client-go/client"
+	"log"
 	"strconv"
-	"strings"
 	"time"
 )
 
-//TODO: adjust forceNew
-
 func resourceAuthLdapSettings() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceAuthLdapSettingsCreate,
 		ReadContext:   resourceAuthLdapSettingsRead,
+		UpdateContext: resourceAuthLdapSettingsUpdate,
 		DeleteContext: resourceAuthLdapSettingsDelete,
 		Schema: map[string]*schema.Schema{
 			"ldap_attrs_enabled": {
 				Type:     schema.TypeBool,
 				Required: true,
-				ForceNew: true, // workaround to solve inconsistent api update 5.4.0
 			},
 			"ldap_attrs_url": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"ldap_attrs_base_dn": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"ldap_attrs_sync_email": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"ldap_attrs_sync_fullname": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 			},
 			"ldap_attrs_sync_photo": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 			},
 			"user_dn_pattern": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 			},
 			"user_search_filter": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 			},
 			"group_search_base": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 			},
 			"group_search_filter": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 			},
 			"password_encoder_type": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice(rpClient.PasswordEncryptionTypes, true),
 			},
 			"password_attr": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 			},
 			"manager_dn": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 			},
+			// sent with every update, removing it from the configuration clears it
 			"manager_password": {
 				Type:      schema.TypeString,
 				Sensitive: true,
 				Optional:  true,
-				ForceNew:  true,
 			},
 			"last_updated": {
 				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
 				Computed: true,
 			},
 		},
@@ -126,7 +110,9 @@ func resourceAuthLdapSettingsRead(ctx context.Context, data *schema.ResourceData
 	client := i.(*rpClient.Client)
 	ldapSettings, err := client.ReadLdapAuthSettings()
 	if err != nil {
-		if strings.Contains(err.Error(), "404") {
+		if rpClient.IsNotFound(err) {
+			log.Printf("[WARN] ReportPortal LDAP settings %s not found, removing them from state", data.Id())
+			data.SetId("")
 			return diags
 		}
 		return diag.FromErr(err)
@@ -144,8 +130,8 @@ func resourceAuthLdapSettingsRead(ctx context.Context, data *schema.ResourceData
 	err = data.Set("group_search_filter", ldapSettings.GroupSearchFilter)
 	err = data.Set("password_encoder_type", ldapSettings.PasswordEncoderType)
 	err = data.Set("password_attr", ldapSettings.PasswordAttribute)
-	err = data.Set("manager_password", ldapSettings.ManagerPassword)
-	err = data.Set("password_attr", ldapSettings.ManagerDn)
+	err = data.Set("manager_dn", ldapSettings.ManagerDn)
+	// the manager password is never returned, the configured one is kept
 
 	if err != nil {
 		return diag.FromErr(err)
@@ -196,18 +182,20 @@ func resourceAuthLdapSettingsUpdate(ctx context.Context, data *schema.ResourceDa
 	var ldapSettings rpClient.LdapIntegrationParameters
 	getSettingsFromData(&ldapSettings, data)
 
-	savedSettings, err := client.UpdateAuthLdapSettings(&ldapSettings)
+	integrationId, err := strconv.Atoi(data.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	data.SetId(strconv.Itoa(*savedSettings.Id))
+	_, err = client.UpdateAuthLdapSettings(&integrationId, &ldapSettings)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	err = data.Set("latest_updated", time.Now().Format(time.RFC3339))
+	err = data.Set("last_updated", time.Now().Format(time.RFC3339))
 	if err != nil {
-		return nil
+		return diag.FromErr(err)
 	}
-	resourceAuthLdapSettingsRead(ctx, data, i)
 
-	return diags
+	return append(diags, resourceAuthLdapSettingsRead(ctx, data, i)...)
 }
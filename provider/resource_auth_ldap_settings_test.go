@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"testing"
+)
+
+func TestAccResourceAuthLdapSettings_basic(t *testing.T) {
+	fake := newFakeReportPortal(t)
+	var ldapId int
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAuthLdapSettingsDestroy(fake),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAuthLdapSettingsConfig(fake, "(member={0})"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthLdapSettingsExists(fake),
+					resource.TestCheckResourceAttr("reportportal_auth_ldap_settings.test", "ldap_attrs_url", "ldap://ldap.example.com:389"),
+					resource.TestCheckResourceAttr("reportportal_auth_ldap_settings.test", "group_search_filter", "(member={0})"),
+					resource.TestCheckResourceAttr("reportportal_auth_ldap_settings.test", "manager_dn", "cn=admin,dc=example,dc=com"),
+					func(*terraform.State) error {
+						ldapId = fake.ldapSettingsId()
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccResourceAuthLdapSettingsConfig(fake, "(uniqueMember={0})"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthLdapSettingsExists(fake),
+					resource.TestCheckResourceAttr("reportportal_auth_ldap_settings.test", "group_search_filter", "(uniqueMember={0})"),
+					func(*terraform.State) error {
+						if id := fake.ldapSettingsId(); id != ldapId {
+							return fmt.Errorf("LDAP settings were recreated: id changed from %d to %d", ldapId, id)
+						}
+						if fake.ldapManagerPassword() != "secret" {
+							return fmt.Errorf("LDAP manager password was not kept on update")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceAuthLdapSettings_managerPassword(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAuthLdapSettingsDestroy(fake),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAuthLdapSettingsPasswordConfig(fake, "(member={0})", `"secret"`),
+				Check:  testAccCheckAuthLdapManagerPassword(fake, "secret"),
+			},
+			{
+				Config: testAccResourceAuthLdapSettingsPasswordConfig(fake, "(member={0})", `"changed"`),
+				Check:  testAccCheckAuthLdapManagerPassword(fake, "changed"),
+			},
+			{
+				// cleared
+				Config: testAccResourceAuthLdapSettingsPasswordConfig(fake, "(member={0})", `""`),
+				Check:  testAccCheckAuthLdapManagerPassword(fake, ""),
+			},
+			{
+				Config: testAccResourceAuthLdapSettingsPasswordConfig(fake, "(member={0})", `"secret"`),
+				Check:  testAccCheckAuthLdapManagerPassword(fake, "secret"),
+			},
+			{
+				// removed from the configuration
+				Config: testAccResourceAuthLdapSettingsPasswordConfig(fake, "(member={0})", "null"),
+				Check:  testAccCheckAuthLdapManagerPassword(fake, ""),
+			},
+			{
+				// a change of another attribute sends the configured password again
+				Config: testAccResourceAuthLdapSettingsPasswordConfig(fake, "(uniqueMember={0})", "null"),
+				Check:  testAccCheckAuthLdapManagerPassword(fake, ""),
+			},
+		},
+	})
+}
+
+func TestAccResourceAuthLdapSettings_disappears(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAuthLdapSettingsDestroy(fake),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAuthLdapSettingsConfig(fake, "(member={0})"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthLdapSettingsExists(fake),
+					func(*terraform.State) error {
+						fake.removeLdapSettings()
+						return nil
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccResourceAuthLdapSettingsConfig(fake, "(member={0})"),
+				Check:  testAccCheckAuthLdapSettingsExists(fake),
+			},
+		},
+	})
+}
+
+func testAccCheckAuthLdapSettingsExists(fake *fakeReportPortal) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if !fake.hasLdapSettings() {
+			return fmt.Errorf("LDAP settings not found")
+		}
+		return nil
+	}
+}
+
+func testAccCheckAuthLdapSettingsDestroy(fake *fakeReportPortal) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if fake.hasLdapSettings() {
+			return fmt.Errorf("LDAP settings still exist")
+		}
+		return nil
+	}
+}
+
+func testAccCheckAuthLdapManagerPassword(fake *fakeReportPortal, password string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if got := fake.ldapManagerPassword(); got != password {
+			return fmt.Errorf("expected the LDAP manager password to be %q, got %q", password, got)
+		}
+		return nil
+	}
+}
+
+func testAccResourceAuthLdapSettingsConfig(fake *fakeReportPortal, groupSearchFilter string) string {
+	return testAccResourceAuthLdapSettingsPasswordConfig(fake, groupSearchFilter, `"secret"`)
+}
+
+// testAccResourceAuthLdapSettingsPasswordConfig takes the manager password as an HCL expression
+func testAccResourceAuthLdapSettingsPasswordConfig(fake *fakeReportPortal, groupSearchFilter, managerPassword string) string {
+	return testAccProviderConfig(fake) + fmt.Sprintf(`
+resource "reportportal_auth_ldap_settings" "test" {
+  ldap_attrs_enabled       = true
+  ldap_attrs_url           = "ldap://ldap.example.com:389"
+  ldap_attrs_base_dn       = "dc=example,dc=com"
+  ldap_attrs_sync_email    = "mail"
+  ldap_attrs_sync_fullname = "cn"
+  user_dn_pattern          = "uid={0},ou=people"
+  group_search_base        = "ou=groups"
+  group_search_filter      = %q
+  password_encoder_type    = "PLAIN"
+  manager_dn               = "cn=admin,dc=example,dc=com"
+  manager_password         = %s
+}
+`, groupSearchFilter, managerPassword)
+}
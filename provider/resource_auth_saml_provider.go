@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	rpClient "github.com/rmalveis/report-portal-client-go/client"
+	"log"
+	"strconv"
+)
+
+func resourceAuthSamlProvider() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAuthSamlProviderCreate,
+		ReadContext:   resourceAuthSamlProviderRead,
+		UpdateContext: resourceAuthSamlProviderUpdate,
+		DeleteContext: resourceAuthSamlProviderDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"metadata_url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"name_attribute": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"email_attribute": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"first_name_attribute": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"full_name_attribute"},
+				RequiredWith:  []string{"last_name_attribute"},
+			},
+			"last_name_attribute": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"full_name_attribute"},
+				RequiredWith:  []string{"first_name_attribute"},
+			},
+			"full_name_attribute": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"full_name_attribute", "first_name_attribute"},
+			},
+			"provider_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAuthSamlProviderCreate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(*rpClient.Client)
+
+	provider, err := client.CreateAuthSamlProvider(data.Get("enabled").(bool), getSamlProviderFromData(data))
+	if err != nil {
+		if rpClient.IsConflict(err) {
+			return diag.Errorf("SAML provider %q already exists, use terraform import to manage it: %s", data.Get("name").(string), err)
+		}
+		return diag.FromErr(err)
+	}
+
+	data.SetId(strconv.Itoa(provider.Id))
+
+	return resourceAuthSamlProviderRead(ctx, data, i)
+}
+
+func resourceAuthSamlProviderRead(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := i.(*rpClient.Client)
+
+	providerId, err := strconv.Atoi(data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	provider, err := client.GetAuthSamlProvider(providerId)
+	if err != nil {
+		if rpClient.IsNotFound(err) {
+			log.Printf("[WARN] ReportPortal SAML provider %d not found, removing it from state", providerId)
+			data.SetId("")
+			return diags
+		}
+		return diag.FromErr(err)
+	}
+
+	for key, value := range flattenSamlProvider(provider) {
+		if err = data.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceAuthSamlProviderUpdate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(*rpClient.Client)
+
+	providerId, err := strconv.Atoi(data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.UpdateAuthSamlProvider(providerId, data.Get("enabled").(bool), getSamlProviderFromData(data))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceAuthSamlProviderRead(ctx, data, i)
+}
+
+func resourceAuthSamlProviderDelete(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := i.(*rpClient.Client)
+
+	providerId, err := strconv.Atoi(data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = client.DeleteIntegration(&providerId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId("")
+
+	return diags
+}
+
+func getSamlProviderFromData(data *schema.ResourceData) *rpClient.SamlIntegrationParameters {
+	return &rpClient.SamlIntegrationParameters{
+		IdentityProviderName:        data.Get("name").(string),
+		IdentityProviderMetadataUrl: data.Get("metadata_url").(string),
+		IdentityProviderNameId:      data.Get("name_attribute").(string),
+		EmailAttribute:              data.Get("email_attribute").(string),
+		FirstNameAttribute:          data.Get("first_name_attribute").(string),
+		LastNameAttribute:           data.Get("last_name_attribute").(string),
+		FullNameAttribute:           data.Get("full_name_attribute").(string),
+	}
+}
+
+// flattenSamlProvider maps the provider to the attributes shared by the resource and the data source
+func flattenSamlProvider(provider *rpClient.SamlProvider) map[string]interface{} {
+	return map[string]interface{}{
+		"name":                 provider.IdentityProviderName,
+		"metadata_url":         provider.IdentityProviderMetadataUrl,
+		"enabled":              provider.Enabled,
+		"name_attribute":       provider.IdentityProviderNameId,
+		"email_attribute":      provider.EmailAttribute,
+		"first_name_attribute": provider.FirstNameAttribute,
+		"last_name_attribute":  provider.LastNameAttribute,
+		"full_name_attribute":  provider.FullNameAttribute,
+		"provider_url":         provider.IdentityProviderUrl,
+	}
+}
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"strconv"
+	"testing"
+)
+
+func TestAccResourceAuthSamlProvider_basic(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAuthSamlProviderDestroy(fake),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAuthSamlProviderConfig(fake, true, "mail"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthSamlProviderExists(fake, "reportportal_auth_saml_provider.test"),
+					resource.TestCheckResourceAttr("reportportal_auth_saml_provider.test", "name", "okta"),
+					resource.TestCheckResourceAttr("reportportal_auth_saml_provider.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("reportportal_auth_saml_provider.test", "email_attribute", "mail"),
+					resource.TestCheckResourceAttr("reportportal_auth_saml_provider.test", "first_name_attribute", "givenName"),
+					resource.TestCheckResourceAttrSet("reportportal_auth_saml_provider.test", "provider_url"),
+				),
+			},
+			{
+				Config: testAccResourceAuthSamlProviderConfig(fake, false, "emailAddress"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthSamlProviderExists(fake, "reportportal_auth_saml_provider.test"),
+					resource.TestCheckResourceAttr("reportportal_auth_saml_provider.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("reportportal_auth_saml_provider.test", "email_attribute", "emailAddress"),
+				),
+			},
+			{
+				ResourceName:      "reportportal_auth_saml_provider.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceAuthSamlProvider_disappears(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAuthSamlProviderDestroy(fake),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAuthSamlProviderConfig(fake, true, "mail"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthSamlProviderExists(fake, "reportportal_auth_saml_provider.test"),
+					func(s *terraform.State) error {
+						id, err := strconv.Atoi(s.RootModule().Resources["reportportal_auth_saml_provider.test"].Primary.ID)
+						if err != nil {
+							return err
+						}
+						fake.removeSamlProvider(id)
+						return nil
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccResourceAuthSamlProviderConfig(fake, true, "mail"),
+				Check:  testAccCheckAuthSamlProviderExists(fake, "reportportal_auth_saml_provider.test"),
+			},
+		},
+	})
+}
+
+func testAccCheckAuthSamlProviderExists(fake *fakeReportPortal, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if !fake.hasSamlProvider(id) {
+			return fmt.Errorf("SAML provider %d not found", id)
+		}
+		return nil
+	}
+}
+
+func testAccCheckAuthSamlProviderDestroy(fake *fakeReportPortal) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "reportportal_auth_saml_provider" {
+				continue
+			}
+
+			id, err := strconv.Atoi(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			if fake.hasSamlProvider(id) {
+				return fmt.Errorf("SAML provider %d still exists", id)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccResourceAuthSamlProviderConfig(fake *fakeReportPortal, enabled bool, emailAttribute string) string {
+	return testAccProviderConfig(fake) + fmt.Sprintf(`
+resource "reportportal_auth_saml_provider" "test" {
+  name                 = "okta"
+  metadata_url         = "https://example.okta.com/app/exk1/sso/saml/metadata"
+  enabled              = %t
+  email_attribute      = %q
+  first_name_attribute = "givenName"
+  last_name_attribute  = "sn"
+}
+`, enabled, emailAttribute)
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	rpClient "github.com/rmalveis/report-portal-client-go/client"
+	"log"
 	"strconv"
 )
 
@@ -14,6 +15,9 @@ func resourceDashboard() *schema.Resource {
 		ReadContext:   resourceDashboardRead,
 		UpdateContext: resourceDashboardUpdate,
 		DeleteContext: resourceDashboardDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceProjectScopedImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"project_name": {
 				Type:     schema.TypeString,
@@ -120,6 +124,11 @@ func resourceDashboardRead(ctx context.Context, data *schema.ResourceData, i int
 	client := i.(*rpClient.Client)
 	dashboard, err := client.GetDashboardById(projectName, &dashboardId)
 	if err != nil {
+		if rpClient.IsNotFound(err) {
+			log.Printf("[WARN] ReportPortal dashboard %d not found in project %s, removing it from state", dashboardId, projectName)
+			data.SetId("")
+			return diags
+		}
 		return diag.FromErr(err)
 	}
 
@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"testing"
+)
+
+func TestAccResourceDashboard_basic(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDashboardDestroy(fake, "reportportal_dashboard.test"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDashboardConfig(fake, "Regression overview", 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(fake, "reportportal_dashboard.test"),
+					resource.TestCheckResourceAttr("reportportal_dashboard.test", "description", "Regression overview"),
+					resource.TestCheckResourceAttr("reportportal_dashboard.test", "widgets.#", "1"),
+					resource.TestCheckResourceAttrPair("reportportal_dashboard.test", "widgets.0.widget_id", "reportportal_widget.test", "id"),
+					resource.TestCheckResourceAttr("reportportal_dashboard.test", "widgets.0.position_y", "0"),
+				),
+			},
+			{
+				Config: testAccResourceDashboardConfig(fake, "Regression overview of the nightly runs", 4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(fake, "reportportal_dashboard.test"),
+					resource.TestCheckResourceAttr("reportportal_dashboard.test", "description", "Regression overview of the nightly runs"),
+					resource.TestCheckResourceAttr("reportportal_dashboard.test", "widgets.0.position_y", "4"),
+				),
+			},
+			{
+				ResourceName:      "reportportal_dashboard.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccProjectScopedImportId("reportportal_dashboard.test"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceDashboard_disappears(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckDashboardDestroy(fake, "reportportal_dashboard.test"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDashboardConfig(fake, "Regression overview", 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(fake, "reportportal_dashboard.test"),
+					func(s *terraform.State) error {
+						id, err := testAccResourceId(s, "reportportal_dashboard.test")
+						if err != nil {
+							return err
+						}
+						fake.removeDashboard(id)
+						return nil
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccResourceDashboardConfig(fake, "Regression overview", 0),
+				Check:  testAccCheckDashboardExists(fake, "reportportal_dashboard.test"),
+			},
+		},
+	})
+}
+
+func testAccCheckDashboardExists(fake *fakeReportPortal, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id, err := testAccResourceId(s, resourceName)
+		if err != nil {
+			return err
+		}
+		if !fake.hasDashboard(id) {
+			return fmt.Errorf("dashboard %d not found", id)
+		}
+		return nil
+	}
+}
+
+func testAccCheckDashboardDestroy(fake *fakeReportPortal, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id, err := testAccResourceId(s, resourceName)
+		if err != nil {
+			return nil
+		}
+		if fake.hasDashboard(id) {
+			return fmt.Errorf("dashboard %d still exists", id)
+		}
+		return nil
+	}
+}
+
+func testAccResourceDashboardConfig(fake *fakeReportPortal, description string, positionY int) string {
+	return testAccProviderConfig(fake) + fmt.Sprintf(`
+resource "reportportal_project" "test" {
+  name = "tf_acc_dashboard"
+}
+
+resource "reportportal_widget" "test" {
+  project_name = reportportal_project.test.name
+  name         = "Launch duration"
+  widget_type  = "Launch duration chart"
+}
+
+resource "reportportal_dashboard" "test" {
+  project_name = reportportal_project.test.name
+  name         = "tf_acc_dashboard"
+  description  = %q
+
+  widgets {
+    widget_id   = reportportal_widget.test.id
+    widget_name = reportportal_widget.test.name
+    widget_type = reportportal_widget.test.widget_type_calculated
+    share       = true
+    position_x  = 0
+    position_y  = %d
+    width       = 6
+    height      = 4
+  }
+}
+`, description, positionY)
+}
@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	rpClient "github.com/rmalveis/report-portal-client-go/client"
+	"log"
+	"strconv"
+)
+
+const emailPluginName = "email"
+
+func resourceEmailServerIntegration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEmailServerIntegrationCreate,
+		ReadContext:   resourceEmailServerIntegrationRead,
+		UpdateContext: resourceEmailServerIntegrationUpdate,
+		DeleteContext: resourceEmailServerIntegrationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Email Server",
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"host": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "smtp",
+				ValidateFunc: validation.StringInSlice([]string{"smtp", "smtps"}, false),
+			},
+			"auth_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"username": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"from": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ssl_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"starttls_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceEmailServerIntegrationCreate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	integration, err := c.CreateIntegration(nil, emailPluginName, mapToEmailServerIntegration(data))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(strconv.Itoa(integration.Id))
+
+	return resourceEmailServerIntegrationRead(ctx, data, i)
+}
+
+func resourceEmailServerIntegrationRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	integrationId, err := strconv.Atoi(data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	integration, err := c.GetIntegration(nil, integrationId)
+	if err != nil {
+		if rpClient.IsNotFound(err) {
+			log.Printf("[WARN] ReportPortal email server integration %d not found, removing it from state", integrationId)
+			data.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	params := integration.IntegrationParameters
+	data.Set("name", integration.Name)
+	data.Set("enabled", integration.Enabled)
+	data.Set("host", integrationString(params, "host"))
+	data.Set("port", integrationInt(params, "port"))
+	data.Set("protocol", integrationString(params, "protocol"))
+	data.Set("auth_enabled", integrationBool(params, "authEnabled"))
+	data.Set("username", integrationString(params, "username"))
+	data.Set("from", integrationString(params, "from"))
+	data.Set("ssl_enabled", integrationBool(params, "sslEnabled"))
+	data.Set("starttls_enabled", integrationBool(params, "starTlsEnabled"))
+	// the password is never returned in clear, the configured one is kept
+
+	var diags diag.Diagnostics
+	return diags
+}
+
+func resourceEmailServerIntegrationUpdate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	integrationId, err := strconv.Atoi(data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = c.UpdateIntegration(nil, integrationId, mapToEmailServerIntegration(data))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceEmailServerIntegrationRead(ctx, data, i)
+}
+
+func resourceEmailServerIntegrationDelete(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	integrationId, err := strconv.Atoi(data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = c.DeleteIntegrationById(nil, integrationId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func mapToEmailServerIntegration(data *schema.ResourceData) *rpClient.IntegrationPayload {
+	params := map[string]interface{}{
+		"host":           data.Get("host").(string),
+		"port":           data.Get("port").(int),
+		"protocol":       data.Get("protocol").(string),
+		"authEnabled":    data.Get("auth_enabled").(bool),
+		"username":       data.Get("username").(string),
+		"password":       data.Get("password").(string),
+		"from":           data.Get("from").(string),
+		"sslEnabled":     data.Get("ssl_enabled").(bool),
+		"starTlsEnabled": data.Get("starttls_enabled").(bool),
+	}
+
+	return &rpClient.IntegrationPayload{
+		Name:                  data.Get("name").(string),
+		Enabled:               data.Get("enabled").(bool),
+		IntegrationParameters: params,
+	}
+}
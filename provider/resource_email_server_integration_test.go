@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"testing"
+)
+
+func TestAccResourceEmailServerIntegration_basic(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckIntegrationDestroy(fake, "reportportal_email_server_integration.test"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceEmailServerIntegrationConfig(fake, "smtp.example.com", 587),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntegrationExists(fake, "reportportal_email_server_integration.test"),
+					testAccCheckIntegrationParameter(fake, "reportportal_email_server_integration.test", "password", "smtp-secret"),
+					resource.TestCheckResourceAttr("reportportal_email_server_integration.test", "host", "smtp.example.com"),
+					resource.TestCheckResourceAttr("reportportal_email_server_integration.test", "port", "587"),
+					resource.TestCheckResourceAttr("reportportal_email_server_integration.test", "auth_enabled", "true"),
+					resource.TestCheckResourceAttr("reportportal_email_server_integration.test", "starttls_enabled", "true"),
+					resource.TestCheckResourceAttr("reportportal_email_server_integration.test", "password", "smtp-secret"),
+				),
+			},
+			{
+				Config: testAccResourceEmailServerIntegrationConfig(fake, "mail.example.com", 2525),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntegrationExists(fake, "reportportal_email_server_integration.test"),
+					testAccCheckIntegrationParameter(fake, "reportportal_email_server_integration.test", "host", "mail.example.com"),
+					resource.TestCheckResourceAttr("reportportal_email_server_integration.test", "host", "mail.example.com"),
+					resource.TestCheckResourceAttr("reportportal_email_server_integration.test", "port", "2525"),
+				),
+			},
+			{
+				ResourceName:            "reportportal_email_server_integration.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func TestAccResourceEmailServerIntegration_disappears(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckIntegrationDestroy(fake, "reportportal_email_server_integration.test"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceEmailServerIntegrationConfig(fake, "smtp.example.com", 587),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntegrationExists(fake, "reportportal_email_server_integration.test"),
+					func(s *terraform.State) error {
+						id, err := testAccResourceId(s, "reportportal_email_server_integration.test")
+						if err != nil {
+							return err
+						}
+						fake.removeIntegration(id)
+						return nil
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccResourceEmailServerIntegrationConfig(fake, "smtp.example.com", 587),
+				Check:  testAccCheckIntegrationExists(fake, "reportportal_email_server_integration.test"),
+			},
+		},
+	})
+}
+
+func testAccCheckIntegrationExists(fake *fakeReportPortal, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id, err := testAccResourceId(s, resourceName)
+		if err != nil {
+			return err
+		}
+		if !fake.hasIntegration(id) {
+			return fmt.Errorf("integration %d not found", id)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIntegrationParameter(fake *fakeReportPortal, resourceName, key string, value interface{}) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id, err := testAccResourceId(s, resourceName)
+		if err != nil {
+			return err
+		}
+		if actual := fake.integrationParameter(id, key); actual != value {
+			return fmt.Errorf("expected integration parameter %s to be %v, got %v", key, value, actual)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIntegrationDestroy(fake *fakeReportPortal, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id, err := testAccResourceId(s, resourceName)
+		if err != nil {
+			return nil
+		}
+		if fake.hasIntegration(id) {
+			return fmt.Errorf("integration %d still exists", id)
+		}
+		return nil
+	}
+}
+
+func testAccResourceEmailServerIntegrationConfig(fake *fakeReportPortal, host string, port int) string {
+	return testAccProviderConfig(fake) + fmt.Sprintf(`
+resource "reportportal_email_server_integration" "test" {
+  host             = %q
+  port             = %d
+  auth_enabled     = true
+  username         = "reportportal"
+  password         = "smtp-secret"
+  from             = "reportportal@example.com"
+  starttls_enabled = true
+}
+`, host, port)
+}
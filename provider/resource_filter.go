@@ -5,6 +5,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	rpClient "github.com/rmalveis/report-portal-client-go/client"
+	"log"
 	"strconv"
 )
 
@@ -14,6 +15,9 @@ func resourceFilter() *schema.Resource {
 		ReadContext:   resourceFilterRead,
 		UpdateContext: resourceFilterUpdate,
 		DeleteContext: resourceFilterDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceProjectScopedImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"project_name": {
 				Type:     schema.TypeString,
@@ -124,6 +128,11 @@ func resourceFilterRead(ctx context.Context, data *schema.ResourceData, i interf
 
 	filter, err := c.GetFilterByProjectAndId(projectName, filterId)
 	if err != nil {
+		if rpClient.IsNotFound(err) {
+			log.Printf("[WARN] ReportPortal filter %d not found in project %s, removing it from state", filterId, projectName)
+			data.SetId("")
+			return nil
+		}
 		return diag.FromErr(err)
 	}
 
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"testing"
+)
+
+func TestAccResourceFilter_basic(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckFilterDestroy(fake, "reportportal_filter.test"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceFilterConfig(fake, "Nightly regression", "regression"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFilterExists(fake, "reportportal_filter.test"),
+					resource.TestCheckResourceAttr("reportportal_filter.test", "description", "Nightly regression"),
+					resource.TestCheckResourceAttr("reportportal_filter.test", "owner", fakeUsername),
+					resource.TestCheckResourceAttr("reportportal_filter.test", "conditions.#", "1"),
+					resource.TestCheckResourceAttr("reportportal_filter.test", "conditions.0.value", "regression"),
+					resource.TestCheckResourceAttr("reportportal_filter.test", "orders.0.sorting_column", "startTime"),
+				),
+			},
+			{
+				Config: testAccResourceFilterConfig(fake, "Nightly smoke", "smoke"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFilterExists(fake, "reportportal_filter.test"),
+					resource.TestCheckResourceAttr("reportportal_filter.test", "description", "Nightly smoke"),
+					resource.TestCheckResourceAttr("reportportal_filter.test", "conditions.0.value", "smoke"),
+				),
+			},
+			{
+				ResourceName:      "reportportal_filter.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccProjectScopedImportId("reportportal_filter.test"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceFilter_disappears(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckFilterDestroy(fake, "reportportal_filter.test"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceFilterConfig(fake, "Nightly regression", "regression"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFilterExists(fake, "reportportal_filter.test"),
+					func(s *terraform.State) error {
+						id, err := testAccResourceId(s, "reportportal_filter.test")
+						if err != nil {
+							return err
+						}
+						fake.removeFilter(id)
+						return nil
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccResourceFilterConfig(fake, "Nightly regression", "regression"),
+				Check:  testAccCheckFilterExists(fake, "reportportal_filter.test"),
+			},
+		},
+	})
+}
+
+func testAccCheckFilterExists(fake *fakeReportPortal, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id, err := testAccResourceId(s, resourceName)
+		if err != nil {
+			return err
+		}
+		if !fake.hasFilter(id) {
+			return fmt.Errorf("filter %d not found", id)
+		}
+		return nil
+	}
+}
+
+func testAccCheckFilterDestroy(fake *fakeReportPortal, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id, err := testAccResourceId(s, resourceName)
+		if err != nil {
+			return nil
+		}
+		if fake.hasFilter(id) {
+			return fmt.Errorf("filter %d still exists", id)
+		}
+		return nil
+	}
+}
+
+func testAccResourceFilterConfig(fake *fakeReportPortal, description, launchName string) string {
+	return testAccProviderConfig(fake) + fmt.Sprintf(`
+resource "reportportal_project" "test" {
+  name = "tf_acc_filter"
+}
+
+resource "reportportal_filter" "test" {
+  project_name = reportportal_project.test.name
+  name         = "tf_acc_filter"
+  type         = "Launch"
+  description  = %q
+
+  orders {
+    sorting_column = "startTime"
+    is_asc         = false
+  }
+
+  conditions {
+    filtering_field = "name"
+    condition       = "cnt"
+    value           = %q
+  }
+}
+`, description, launchName)
+}
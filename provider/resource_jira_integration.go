@@ -0,0 +1,253 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	rpClient "github.com/rmalveis/report-portal-client-go/client"
+	"log"
+	"strconv"
+)
+
+const jiraPluginName = "jira"
+
+func resourceJiraIntegration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceJiraIntegrationCreate,
+		ReadContext:   resourceJiraIntegrationRead,
+		UpdateContext: resourceJiraIntegrationUpdate,
+		DeleteContext: resourceJiraIntegrationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceProjectScopedImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Jira",
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"jira_project": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"auth_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "BASIC",
+				ValidateFunc: validation.StringInSlice([]string{"BASIC", "OAUTH"}, false),
+			},
+			"username": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"access_key": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"issue_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"field": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "string",
+						},
+						"required": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"value": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceJiraIntegrationCreate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	projectName := data.Get("project_name").(string)
+
+	integration, err := c.CreateIntegration(&projectName, jiraPluginName, mapToJiraIntegration(data))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(strconv.Itoa(integration.Id))
+
+	return resourceJiraIntegrationRead(ctx, data, i)
+}
+
+func resourceJiraIntegrationRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	projectName := data.Get("project_name").(string)
+	integrationId, err := strconv.Atoi(data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	integration, err := c.GetIntegration(&projectName, integrationId)
+	if err != nil {
+		if rpClient.IsNotFound(err) {
+			log.Printf("[WARN] ReportPortal Jira integration %d not found in project %s, removing it from state", integrationId, projectName)
+			data.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	params := integration.IntegrationParameters
+	data.Set("name", integration.Name)
+	data.Set("enabled", integration.Enabled)
+	data.Set("url", integrationString(params, "url"))
+	data.Set("jira_project", integrationString(params, "project"))
+	data.Set("auth_type", integrationString(params, "authType"))
+	data.Set("username", integrationString(params, "username"))
+	data.Set("issue_type", integrationString(params, "issueType"))
+	if err := data.Set("field", jiraFieldsToMap(params["defectFormFields"])); err != nil {
+		return diag.FromErr(err)
+	}
+	// credentials are never returned in clear, the configured ones are kept
+
+	var diags diag.Diagnostics
+	return diags
+}
+
+func resourceJiraIntegrationUpdate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	projectName := data.Get("project_name").(string)
+	integrationId, err := strconv.Atoi(data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = c.UpdateIntegration(&projectName, integrationId, mapToJiraIntegration(data))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceJiraIntegrationRead(ctx, data, i)
+}
+
+func resourceJiraIntegrationDelete(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	projectName := data.Get("project_name").(string)
+	integrationId, err := strconv.Atoi(data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = c.DeleteIntegrationById(&projectName, integrationId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func mapToJiraIntegration(data *schema.ResourceData) *rpClient.IntegrationPayload {
+	fields := make([]map[string]interface{}, 0)
+	for _, f := range data.Get("field").([]interface{}) {
+		field := f.(map[string]interface{})
+		fields = append(fields, map[string]interface{}{
+			"id":         field["id"].(string),
+			"fieldName":  field["name"].(string),
+			"fieldType":  field["type"].(string),
+			"isRequired": field["required"].(bool),
+			"value":      toStringSlice(field["value"].([]interface{})),
+		})
+	}
+
+	params := map[string]interface{}{
+		"url":              data.Get("url").(string),
+		"project":          data.Get("jira_project").(string),
+		"authType":         data.Get("auth_type").(string),
+		"issueType":        data.Get("issue_type").(string),
+		"defectFormFields": fields,
+	}
+	if data.Get("auth_type").(string) == "OAUTH" {
+		params["oauthAccessKey"] = data.Get("access_key").(string)
+	} else {
+		params["username"] = data.Get("username").(string)
+		params["password"] = data.Get("password").(string)
+	}
+
+	return &rpClient.IntegrationPayload{
+		Name:                  data.Get("name").(string),
+		Enabled:               data.Get("enabled").(bool),
+		IntegrationParameters: params,
+	}
+}
+
+func jiraFieldsToMap(defectFormFields interface{}) []map[string]interface{} {
+	rawFields, _ := defectFormFields.([]interface{})
+	fields := make([]map[string]interface{}, 0, len(rawFields))
+	for _, f := range rawFields {
+		field, ok := f.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		values := make([]string, 0)
+		rawValues, _ := field["value"].([]interface{})
+		for _, v := range rawValues {
+			values = append(values, toIntegrationString(v))
+		}
+
+		fields = append(fields, map[string]interface{}{
+			"id":       integrationString(field, "id"),
+			"name":     integrationString(field, "fieldName"),
+			"type":     integrationString(field, "fieldType"),
+			"required": integrationBool(field, "isRequired"),
+			"value":    values,
+		})
+	}
+	return fields
+}
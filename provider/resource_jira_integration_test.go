@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"testing"
+)
+
+func TestAccResourceJiraIntegration_basic(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckIntegrationDestroy(fake, "reportportal_jira_integration.test"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceJiraIntegrationConfig(fake, "Bug", "QA"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntegrationExists(fake, "reportportal_jira_integration.test"),
+					testAccCheckIntegrationParameter(fake, "reportportal_jira_integration.test", "password", "jira-token"),
+					testAccCheckIntegrationParameter(fake, "reportportal_jira_integration.test", "project", "QA"),
+					resource.TestCheckResourceAttr("reportportal_jira_integration.test", "url", "https://jira.example.com"),
+					resource.TestCheckResourceAttr("reportportal_jira_integration.test", "issue_type", "Bug"),
+					resource.TestCheckResourceAttr("reportportal_jira_integration.test", "field.#", "2"),
+					resource.TestCheckResourceAttr("reportportal_jira_integration.test", "field.0.name", "Summary"),
+					resource.TestCheckResourceAttr("reportportal_jira_integration.test", "field.1.value.0", "reportportal"),
+				),
+			},
+			{
+				Config: testAccResourceJiraIntegrationConfig(fake, "Task", "QAOPS"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntegrationExists(fake, "reportportal_jira_integration.test"),
+					resource.TestCheckResourceAttr("reportportal_jira_integration.test", "issue_type", "Task"),
+					resource.TestCheckResourceAttr("reportportal_jira_integration.test", "jira_project", "QAOPS"),
+				),
+			},
+			{
+				ResourceName:            "reportportal_jira_integration.test",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccProjectScopedImportId("reportportal_jira_integration.test"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func TestAccResourceJiraIntegration_disappears(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckIntegrationDestroy(fake, "reportportal_jira_integration.test"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceJiraIntegrationConfig(fake, "Bug", "QA"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIntegrationExists(fake, "reportportal_jira_integration.test"),
+					func(s *terraform.State) error {
+						id, err := testAccResourceId(s, "reportportal_jira_integration.test")
+						if err != nil {
+							return err
+						}
+						fake.removeIntegration(id)
+						return nil
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccResourceJiraIntegrationConfig(fake, "Bug", "QA"),
+				Check:  testAccCheckIntegrationExists(fake, "reportportal_jira_integration.test"),
+			},
+		},
+	})
+}
+
+func testAccResourceJiraIntegrationConfig(fake *fakeReportPortal, issueType, jiraProject string) string {
+	return testAccProviderConfig(fake) + fmt.Sprintf(`
+resource "reportportal_project" "test" {
+  name = "tf_acc_jira"
+}
+
+resource "reportportal_jira_integration" "test" {
+  project_name = reportportal_project.test.name
+  url          = "https://jira.example.com"
+  jira_project = %q
+  username     = "reportportal"
+  password     = "jira-token"
+  issue_type   = %q
+
+  field {
+    id       = "summary"
+    name     = "Summary"
+    required = true
+  }
+
+  field {
+    id    = "labels"
+    name  = "Labels"
+    type  = "array"
+    value = ["reportportal"]
+  }
+}
+`, jiraProject, issueType)
+}
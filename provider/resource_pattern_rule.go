@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	rpClient "github.com/rmalveis/report-portal-client-go/client"
+	"log"
+	"regexp/syntax"
+	"strconv"
+)
+
+func resourcePatternRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePatternRuleCreate,
+		ReadContext:   resourcePatternRuleRead,
+		UpdateContext: resourcePatternRuleUpdate,
+		DeleteContext: resourcePatternRuleDelete,
+		CustomizeDiff: resourcePatternRuleCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceProjectScopedImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(rpClient.PatternTypes, false),
+			},
+			"value": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+// resourcePatternRuleCustomizeDiff rejects malformed REGEX patterns during the plan,
+// instead of letting ReportPortal store a pattern which never matches.
+func resourcePatternRuleCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Get("type").(string) != "REGEX" || !diff.NewValueKnown("value") {
+		return nil
+	}
+
+	return validatePatternRegex(diff.Get("value").(string))
+}
+
+// malformedRegexErrors are the RE2 parse errors of patterns Java rejects as well. ReportPortal
+// compiles the patterns with java.util.regex, which supports constructs RE2 does not, such as
+// lookarounds, backreferences and possessive quantifiers, so other RE2 errors are left to the server.
+var malformedRegexErrors = map[syntax.ErrorCode]bool{
+	syntax.ErrMissingParen:          true,
+	syntax.ErrUnexpectedParen:       true,
+	syntax.ErrMissingBracket:        true,
+	syntax.ErrTrailingBackslash:     true,
+	syntax.ErrMissingRepeatArgument: true,
+}
+
+func validatePatternRegex(value string) error {
+	_, err := syntax.Parse(value, syntax.Perl)
+	if parseErr, ok := err.(*syntax.Error); ok && malformedRegexErrors[parseErr.Code] {
+		return fmt.Errorf("value %q is not a valid regular expression: %w", value, err)
+	}
+
+	return nil
+}
+
+func resourcePatternRuleCreate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	projectName := data.Get("project_name").(string)
+	name := data.Get("name").(string)
+
+	result, err := c.CreatePatternRule(projectName, &rpClient.PatternRule{
+		Name:    name,
+		Type:    data.Get("type").(string),
+		Value:   data.Get("value").(string),
+		Enabled: data.Get("enabled").(bool),
+	})
+	if err != nil {
+		if rpClient.IsConflict(err) {
+			return diag.Errorf("pattern %q already exists in project %q, use terraform import to manage it: %s", name, projectName, err)
+		}
+		return diag.FromErr(err)
+	}
+
+	data.SetId(strconv.Itoa(result.Id))
+
+	return resourcePatternRuleRead(ctx, data, i)
+}
+
+func resourcePatternRuleRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	projectName := data.Get("project_name").(string)
+	patternId, err := strconv.Atoi(data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	pattern, err := c.GetPatternRule(projectName, patternId)
+	if err != nil {
+		if rpClient.IsNotFound(err) {
+			log.Printf("[WARN] ReportPortal pattern %d not found in project %s, removing it from state", patternId, projectName)
+			data.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	data.Set("name", pattern.Name)
+	data.Set("type", pattern.Type)
+	data.Set("value", pattern.Value)
+	data.Set("enabled", pattern.Enabled)
+
+	var diags diag.Diagnostics
+	return diags
+}
+
+func resourcePatternRuleUpdate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	projectName := data.Get("project_name").(string)
+	patternId, err := strconv.Atoi(data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = c.UpdatePatternRule(projectName, patternId, &rpClient.UpdatePatternRuleRequest{
+		Name:    data.Get("name").(string),
+		Enabled: data.Get("enabled").(bool),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourcePatternRuleRead(ctx, data, i)
+}
+
+func resourcePatternRuleDelete(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	projectName := data.Get("project_name").(string)
+	patternId, err := strconv.Atoi(data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = c.DeletePatternRule(projectName, patternId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
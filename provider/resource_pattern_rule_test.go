@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"regexp"
+	"testing"
+)
+
+func TestAccResourcePatternRule_basic(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckPatternRuleDestroy(fake, "reportportal_pattern_rule.test"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePatternRuleConfig(fake, "Connection refused", "REGEX", `Connection refused: .*:\d+`, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPatternRuleExists(fake, "reportportal_pattern_rule.test"),
+					resource.TestCheckResourceAttr("reportportal_pattern_rule.test", "type", "REGEX"),
+					resource.TestCheckResourceAttr("reportportal_pattern_rule.test", "value", `Connection refused: .*:\d+`),
+					resource.TestCheckResourceAttr("reportportal_pattern_rule.test", "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccResourcePatternRuleConfig(fake, "Network failure", "REGEX", `Connection refused: .*:\d+`, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPatternRuleExists(fake, "reportportal_pattern_rule.test"),
+					resource.TestCheckResourceAttr("reportportal_pattern_rule.test", "name", "Network failure"),
+					resource.TestCheckResourceAttr("reportportal_pattern_rule.test", "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      "reportportal_pattern_rule.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccProjectScopedImportId("reportportal_pattern_rule.test"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourcePatternRule_disappears(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckPatternRuleDestroy(fake, "reportportal_pattern_rule.test"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePatternRuleConfig(fake, "Out of memory", "STRING", "java.lang.OutOfMemoryError", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPatternRuleExists(fake, "reportportal_pattern_rule.test"),
+					func(s *terraform.State) error {
+						id, err := testAccResourceId(s, "reportportal_pattern_rule.test")
+						if err != nil {
+							return err
+						}
+						fake.removePatternRule(id)
+						return nil
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccResourcePatternRuleConfig(fake, "Out of memory", "STRING", "java.lang.OutOfMemoryError", true),
+				Check:  testAccCheckPatternRuleExists(fake, "reportportal_pattern_rule.test"),
+			},
+		},
+	})
+}
+
+func TestAccResourcePatternRule_invalidRegex(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourcePatternRuleConfig(fake, "Broken", "REGEX", "Timeout (after", true),
+				ExpectError: regexp.MustCompile(`is not a valid regular expression`),
+			},
+		},
+	})
+}
+
+func TestAccResourcePatternRule_javaRegex(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckPatternRuleDestroy(fake, "reportportal_pattern_rule.test"),
+		Steps: []resource.TestStep{
+			{
+				// lookarounds are not supported by Go but by ReportPortal
+				Config: testAccResourcePatternRuleConfig(fake, "Timeout", "REGEX", `(?<!retry )Timeout(?= after \d+s)`, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPatternRuleExists(fake, "reportportal_pattern_rule.test"),
+					resource.TestCheckResourceAttr("reportportal_pattern_rule.test", "value", `(?<!retry )Timeout(?= after \d+s)`),
+				),
+			},
+		},
+	})
+}
+
+func TestValidatePatternRegex(t *testing.T) {
+	cases := []struct {
+		value     string
+		wantError bool
+	}{
+		{`Timeout after \d+s`, false},
+		{`Timeout(?= after)`, false},
+		{`(?<!retry )Timeout`, false},
+		{`(?>atomic)group`, false},
+		{`(Timeout) \1`, false},
+		{`Time+out*+`, false},
+		{`\p{javaLowerCase}+`, false},
+		{`Timeout (after`, true},
+		{`Timeout) after`, true},
+		{`[Timeout`, true},
+		{`Timeout\`, true},
+		{`*Timeout`, true},
+	}
+
+	for _, c := range cases {
+		if err := validatePatternRegex(c.value); (err != nil) != c.wantError {
+			t.Errorf("validatePatternRegex(%q): expected error %t, got %v", c.value, c.wantError, err)
+		}
+	}
+}
+
+func testAccCheckPatternRuleExists(fake *fakeReportPortal, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id, err := testAccResourceId(s, resourceName)
+		if err != nil {
+			return err
+		}
+		if !fake.hasPatternRule(id) {
+			return fmt.Errorf("pattern %d not found", id)
+		}
+		return nil
+	}
+}
+
+func testAccCheckPatternRuleDestroy(fake *fakeReportPortal, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id, err := testAccResourceId(s, resourceName)
+		if err != nil {
+			return nil
+		}
+		if fake.hasPatternRule(id) {
+			return fmt.Errorf("pattern %d still exists", id)
+		}
+		return nil
+	}
+}
+
+func testAccResourcePatternRuleConfig(fake *fakeReportPortal, name, patternType, value string, enabled bool) string {
+	return testAccProviderConfig(fake) + fmt.Sprintf(`
+resource "reportportal_project" "test" {
+  name                     = "tf_acc_pattern"
+  pattern_analysis_enabled = true
+}
+
+resource "reportportal_pattern_rule" "test" {
+  project_name = reportportal_project.test.name
+  name         = %q
+  type         = %q
+  value        = %q
+  enabled      = %t
+}
+`, name, patternType, value, enabled)
+}
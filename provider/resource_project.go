@@ -2,18 +2,24 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	rpClient "github.com/rmalveis/report-portal-client-go/client"
+	"log"
 	"strconv"
-	"strings"
 )
 
 func resourceProject() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceProjectCreate,
 		ReadContext:   resourceProjectRead,
+		UpdateContext: resourceProjectUpdate,
 		DeleteContext: resourceProjectDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceProjectImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"creation_date": {
 				Type:     schema.TypeInt,
@@ -68,10 +74,65 @@ func resourceProject() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"interrupted_launch_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"keep_launches": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"keep_logs": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"keep_screenshots": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"auto_analysis_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"pattern_analysis_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 		},
 	}
 }
 
+// projectAttributeSet maps resource keys to ReportPortal project configuration attributes, by type
+type projectAttributeSet struct {
+	ints    map[string]string
+	bools   map[string]string
+	strings map[string]string
+}
+
+// Configuration attributes of the project, durations are expressed in seconds (0 means forever)
+var projectAttributes = projectAttributeSet{
+	ints: map[string]string{
+		"interrupted_launch_timeout": rpClient.ProjectAttributeInterruptJobTime,
+		"keep_launches":              rpClient.ProjectAttributeKeepLaunches,
+		"keep_logs":                  rpClient.ProjectAttributeKeepLogs,
+		"keep_screenshots":           rpClient.ProjectAttributeKeepScreenshots,
+	},
+	bools: map[string]string{
+		"auto_analysis_enabled":    rpClient.ProjectAttributeAutoAnalyzerEnabled,
+		"pattern_analysis_enabled": rpClient.ProjectAttributePatternAnalysisEnabled,
+	},
+}
+
 func resourceProjectDelete(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -99,14 +160,39 @@ func resourceProjectCreate(ctx context.Context, data *schema.ResourceData, i int
 
 	project, err := client.CreateProject(&pn)
 	if err != nil {
+		if rpClient.IsConflict(err) {
+			return diag.Errorf("project %q already exists, use terraform import to manage it: %s", pn, err)
+		}
 		return diag.FromErr(err)
 	}
 
 	data.SetId(strconv.Itoa(project.Id))
 
-	resourceProjectRead(ctx, data, i)
+	attributes := getProjectAttributes(data, projectAttributes, false)
+	if len(attributes) > 0 {
+		err = client.UpdateProjectConfiguration(&pn, attributes)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
-	return diags
+	return append(diags, resourceProjectRead(ctx, data, i)...)
+}
+
+func resourceProjectUpdate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client := i.(*rpClient.Client)
+
+	pn := data.Get("name").(string)
+
+	attributes := getProjectAttributes(data, projectAttributes, true)
+	if len(attributes) > 0 {
+		err := client.UpdateProjectConfiguration(&pn, attributes)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceProjectRead(ctx, data, i)
 }
 
 func resourceProjectRead(_ context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
@@ -117,7 +203,9 @@ func resourceProjectRead(_ context.Context, data *schema.ResourceData, i interfa
 
 	project, err := client.GetProjectByName(&pn)
 	if err != nil {
-		if strings.Contains(err.Error(), "404") {
+		if rpClient.IsNotFound(err) {
+			log.Printf("[WARN] ReportPortal project %s not found, removing it from state", pn)
+			data.SetId("")
 			return diags
 		}
 		return diag.FromErr(err)
@@ -127,7 +215,7 @@ func resourceProjectRead(_ context.Context, data *schema.ResourceData, i interfa
 	data.Set("entry_type", project.EntryType)
 	data.Set("last_run", project.LastRun)
 
-	lpu := make([]map[string]interface{}, len(project.LaunchesPerUser))
+	lpu := make([]map[string]interface{}, 0, len(project.LaunchesPerUser))
 	for _, v := range project.LaunchesPerUser {
 		l := make(map[string]interface{})
 		l["count"] = v.Count
@@ -142,5 +230,102 @@ func resourceProjectRead(_ context.Context, data *schema.ResourceData, i interfa
 	data.Set("unique_tickets", project.UniqueTickets)
 	data.Set("users_quantity", project.UsersQuantity)
 
+	projectResource, err := client.GetProjectConfiguration(&pn)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = setProjectAttributes(data, projectAttributes, projectResource.Configuration.Attributes)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	return diags
 }
+
+// getProjectAttributes maps the configured project attributes, or only the changed ones,
+// to the ReportPortal configuration attributes.
+func getProjectAttributes(data *schema.ResourceData, set projectAttributeSet, onlyChanged bool) map[string]string {
+	attributes := make(map[string]string)
+	for key, attribute := range set.ints {
+		if v, ok := getProjectAttribute(data, key, onlyChanged); ok {
+			attributes[attribute] = strconv.Itoa(v.(int))
+		}
+	}
+	for key, attribute := range set.bools {
+		if v, ok := getProjectAttribute(data, key, onlyChanged); ok {
+			attributes[attribute] = strconv.FormatBool(v.(bool))
+		}
+	}
+	for key, attribute := range set.strings {
+		if v, ok := getProjectAttribute(data, key, onlyChanged); ok {
+			attributes[attribute] = v.(string)
+		}
+	}
+	return attributes
+}
+
+func getProjectAttribute(data *schema.ResourceData, key string, onlyChanged bool) (interface{}, bool) {
+	if onlyChanged {
+		return data.Get(key), data.HasChange(key)
+	}
+	// GetOk would ignore attributes explicitly configured with their zero value
+	return data.GetOkExists(key)
+}
+
+func setProjectAttributes(data *schema.ResourceData, set projectAttributeSet, attributes map[string]string) error {
+	for key, attribute := range set.ints {
+		value, ok := attributes[attribute]
+		if !ok {
+			continue
+		}
+		v, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("unexpected value %q for the project attribute %s: %w", value, attribute, err)
+		}
+		if err = data.Set(key, v); err != nil {
+			return err
+		}
+	}
+	for key, attribute := range set.bools {
+		value, ok := attributes[attribute]
+		if !ok {
+			continue
+		}
+		v, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("unexpected value %q for the project attribute %s: %w", value, attribute, err)
+		}
+		if err = data.Set(key, v); err != nil {
+			return err
+		}
+	}
+	for key, attribute := range set.strings {
+		if value, ok := attributes[attribute]; ok {
+			if err := data.Set(key, value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// resourceProjectImport accepts the project name as import ID and replaces it
+// with the numeric project ID used by the remaining operations.
+func resourceProjectImport(ctx context.Context, data *schema.ResourceData, i interface{}) ([]*schema.ResourceData, error) {
+	client := i.(*rpClient.Client)
+
+	pn := data.Id()
+
+	project, err := client.GetProjectByName(&pn)
+	if err != nil {
+		return nil, err
+	}
+
+	data.SetId(strconv.Itoa(project.Id))
+	if err = data.Set("name", pn); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{data}, nil
+}
@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	rpClient "github.com/rmalveis/report-portal-client-go/client"
+	"log"
+	"strconv"
+)
+
+var analyzerAttributes = projectAttributeSet{
+	ints: map[string]string{
+		"min_should_match":    rpClient.ProjectAttributeAnalyzerMinShouldMatch,
+		"min_doc_freq":        rpClient.ProjectAttributeAnalyzerMinDocFreq,
+		"number_of_log_lines": rpClient.ProjectAttributeAnalyzerNumberOfLogLines,
+	},
+	bools: map[string]string{
+		"all_messages_should_match": rpClient.ProjectAttributeAnalyzerAllMessagesShouldMatch,
+	},
+	strings: map[string]string{
+		"mode": rpClient.ProjectAttributeAnalyzerMode,
+	},
+}
+
+// analyzerDefaultAttributes are the values of a new project, restored when the resource is destroyed
+var analyzerDefaultAttributes = map[string]string{
+	rpClient.ProjectAttributeAnalyzerMode:                   "LAUNCH_NAME",
+	rpClient.ProjectAttributeAnalyzerMinShouldMatch:         "95",
+	rpClient.ProjectAttributeAnalyzerMinDocFreq:             "1",
+	rpClient.ProjectAttributeAnalyzerNumberOfLogLines:       "-1",
+	rpClient.ProjectAttributeAnalyzerAllMessagesShouldMatch: "false",
+}
+
+func resourceProjectAnalyzerSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceProjectAnalyzerSettingsCreate,
+		ReadContext:   resourceProjectAnalyzerSettingsRead,
+		UpdateContext: resourceProjectAnalyzerSettingsUpdate,
+		DeleteContext: resourceProjectAnalyzerSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceProjectAnalyzerSettingsImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(rpClient.AnalyzerModes, false),
+			},
+			"min_should_match": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(50, 100),
+			},
+			"min_doc_freq": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 10),
+			},
+			// -1 analyzes all the log lines
+			"number_of_log_lines": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.Any(
+					validation.IntInSlice([]int{-1}),
+					validation.IntBetween(1, 5),
+				),
+			},
+			"all_messages_should_match": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			// ReportPortal does not report whether the index exists, so the value is only
+			// applied: enabling it generates the index again, disabling it deletes the index
+			"indexing_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			// ReportPortal reports whether the index is being rebuilt, it cannot be configured
+			"indexing_running": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceProjectAnalyzerSettingsCreate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	projectName := data.Get("project_name").(string)
+
+	attributes := getProjectAttributes(data, analyzerAttributes, false)
+	if len(attributes) > 0 {
+		err := c.UpdateProjectConfiguration(&projectName, attributes)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// new projects are indexed, so only a disabled index needs a request
+	if !data.Get("indexing_enabled").(bool) {
+		if err := updateProjectIndex(c, projectName, false); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	data.SetId(projectName)
+
+	return resourceProjectAnalyzerSettingsRead(ctx, data, i)
+}
+
+func resourceProjectAnalyzerSettingsRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	projectName := data.Id()
+
+	project, err := c.GetProjectConfiguration(&projectName)
+	if err != nil {
+		if rpClient.IsNotFound(err) {
+			log.Printf("[WARN] ReportPortal project %s not found, removing its analyzer settings from state", projectName)
+			data.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	data.Set("project_name", projectName)
+	err = setProjectAttributes(data, analyzerAttributes, project.Configuration.Attributes)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	indexingRunning, _ := strconv.ParseBool(project.Configuration.Attributes[rpClient.ProjectAttributeAnalyzerIndexingRunning])
+	data.Set("indexing_running", indexingRunning)
+
+	var diags diag.Diagnostics
+	return diags
+}
+
+func resourceProjectAnalyzerSettingsUpdate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	projectName := data.Id()
+
+	attributes := getProjectAttributes(data, analyzerAttributes, true)
+	if len(attributes) > 0 {
+		err := c.UpdateProjectConfiguration(&projectName, attributes)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if data.HasChange("indexing_enabled") {
+		if err := updateProjectIndex(c, projectName, data.Get("indexing_enabled").(bool)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceProjectAnalyzerSettingsRead(ctx, data, i)
+}
+
+func resourceProjectAnalyzerSettingsDelete(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	projectName := data.Id()
+
+	err := c.UpdateProjectConfiguration(&projectName, analyzerDefaultAttributes)
+	if err != nil {
+		if rpClient.IsNotFound(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if !data.Get("indexing_enabled").(bool) {
+		if err := updateProjectIndex(c, projectName, true); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// resourceProjectAnalyzerSettingsImport assumes the index of the imported project exists,
+// as ReportPortal does not report it.
+func resourceProjectAnalyzerSettingsImport(ctx context.Context, data *schema.ResourceData, i interface{}) ([]*schema.ResourceData, error) {
+	if err := data.Set("indexing_enabled", true); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{data}, nil
+}
+
+// updateProjectIndex generates the analyzer index of the project again or deletes it.
+func updateProjectIndex(c *rpClient.Client, projectName string, enabled bool) error {
+	if enabled {
+		return c.GenerateProjectIndex(&projectName)
+	}
+	return c.DeleteProjectIndex(&projectName)
+}
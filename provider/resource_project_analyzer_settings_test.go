@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	rpClient "github.com/rmalveis/report-portal-client-go/client"
+	"regexp"
+	"testing"
+)
+
+func TestAccResourceProjectAnalyzerSettings_basic(t *testing.T) {
+	fake := newFakeReportPortal(t)
+	fake.addProject("tf_acc_analyzer")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckProjectAttribute(fake, "tf_acc_analyzer", rpClient.ProjectAttributeAnalyzerMinShouldMatch, "95"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceProjectAnalyzerSettingsConfig(fake, "ALL", 80),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectAttribute(fake, "tf_acc_analyzer", rpClient.ProjectAttributeAnalyzerMode, "ALL"),
+					testAccCheckProjectAttribute(fake, "tf_acc_analyzer", rpClient.ProjectAttributeAnalyzerMinShouldMatch, "80"),
+					testAccCheckProjectAttribute(fake, "tf_acc_analyzer", rpClient.ProjectAttributeAnalyzerNumberOfLogLines, "3"),
+					testAccCheckProjectAttribute(fake, "tf_acc_analyzer", rpClient.ProjectAttributeAnalyzerAllMessagesShouldMatch, "true"),
+					resource.TestCheckResourceAttr("reportportal_project_analyzer_settings.test", "min_doc_freq", "1"),
+					resource.TestCheckResourceAttr("reportportal_project_analyzer_settings.test", "indexing_running", "false"),
+				),
+			},
+			{
+				Config: testAccResourceProjectAnalyzerSettingsConfig(fake, "CURRENT_LAUNCH", 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectAttribute(fake, "tf_acc_analyzer", rpClient.ProjectAttributeAnalyzerMode, "CURRENT_LAUNCH"),
+					testAccCheckProjectAttribute(fake, "tf_acc_analyzer", rpClient.ProjectAttributeAnalyzerMinShouldMatch, "100"),
+					resource.TestCheckResourceAttr("reportportal_project_analyzer_settings.test", "mode", "CURRENT_LAUNCH"),
+				),
+			},
+			{
+				ResourceName:      "reportportal_project_analyzer_settings.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceProjectAnalyzerSettings_validation(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceProjectAnalyzerSettingsConfig(fake, "ALL", 40),
+				ExpectError: regexp.MustCompile(`expected min_should_match to be in the range \(50 - 100\)`),
+			},
+			{
+				Config:      testAccResourceProjectAnalyzerSettingsConfig(fake, "EVERYTHING", 80),
+				ExpectError: regexp.MustCompile(`expected mode to be one of`),
+			},
+		},
+	})
+}
+
+func TestAccResourceProjectAnalyzerSettings_indexing(t *testing.T) {
+	fake := newFakeReportPortal(t)
+	fake.addProject("tf_acc_analyzer")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckProjectIndex(fake, "tf_acc_analyzer", true),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceProjectAnalyzerSettingsIndexingConfig(fake, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectIndex(fake, "tf_acc_analyzer", false),
+					resource.TestCheckResourceAttr("reportportal_project_analyzer_settings.test", "indexing_enabled", "false"),
+				),
+			},
+			{
+				Config: testAccResourceProjectAnalyzerSettingsIndexingConfig(fake, true),
+				Check:  testAccCheckProjectIndex(fake, "tf_acc_analyzer", true),
+			},
+			{
+				Config: testAccResourceProjectAnalyzerSettingsIndexingConfig(fake, false),
+				Check:  testAccCheckProjectIndex(fake, "tf_acc_analyzer", false),
+			},
+		},
+	})
+}
+
+func testAccCheckProjectIndex(fake *fakeReportPortal, projectName string, indexed bool) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if fake.hasProjectIndex(projectName) != indexed {
+			return fmt.Errorf("expected the index of project %s to exist: %t", projectName, indexed)
+		}
+		return nil
+	}
+}
+
+func testAccResourceProjectAnalyzerSettingsConfig(fake *fakeReportPortal, mode string, minShouldMatch int) string {
+	return testAccProviderConfig(fake) + fmt.Sprintf(`
+resource "reportportal_project_analyzer_settings" "test" {
+  project_name              = "tf_acc_analyzer"
+  mode                      = %q
+  min_should_match          = %d
+  number_of_log_lines       = 3
+  all_messages_should_match = true
+}
+`, mode, minShouldMatch)
+}
+
+func testAccResourceProjectAnalyzerSettingsIndexingConfig(fake *fakeReportPortal, indexingEnabled bool) string {
+	return testAccProviderConfig(fake) + fmt.Sprintf(`
+resource "reportportal_project_analyzer_settings" "test" {
+  project_name     = "tf_acc_analyzer"
+  indexing_enabled = %t
+}
+`, indexingEnabled)
+}
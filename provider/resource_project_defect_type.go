@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	rpClient "github.com/rmalveis/report-portal-client-go/client"
+	"log"
+	"regexp"
+	"strconv"
+)
+
+var defectTypeColorRegexp = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+func resourceProjectDefectType() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceProjectDefectTypeCreate,
+		ReadContext:   resourceProjectDefectTypeRead,
+		UpdateContext: resourceProjectDefectTypeUpdate,
+		DeleteContext: resourceProjectDefectTypeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceProjectScopedImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type_group": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(rpClient.IssueTypeGroups, false),
+			},
+			"long_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(3, 55),
+			},
+			"short_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 4),
+			},
+			"color": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(defectTypeColorRegexp, "must be a hex color like #ffb743"),
+			},
+			"locator": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_field": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceProjectDefectTypeCreate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	projectName := data.Get("project_name").(string)
+
+	subType := rpClient.IssueSubType{
+		TypeRef:   data.Get("type_group").(string),
+		LongName:  data.Get("long_name").(string),
+		ShortName: data.Get("short_name").(string),
+		Color:     data.Get("color").(string),
+	}
+	result, err := c.CreateIssueSubType(projectName, &subType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(strconv.Itoa(result.Id))
+
+	return resourceProjectDefectTypeRead(ctx, data, i)
+}
+
+func resourceProjectDefectTypeRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	projectName := data.Get("project_name").(string)
+	subTypeId, err := strconv.Atoi(data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	subType, err := c.GetIssueSubType(projectName, subTypeId)
+	if err != nil {
+		if rpClient.IsNotFound(err) {
+			log.Printf("[WARN] ReportPortal defect type %d not found in project %s, removing it from state", subTypeId, projectName)
+			data.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	data.Set("type_group", subType.TypeRef)
+	data.Set("long_name", subType.LongName)
+	data.Set("short_name", subType.ShortName)
+	data.Set("color", subType.Color)
+	data.Set("locator", subType.Locator)
+	data.Set("content_field", subType.ContentField())
+
+	var diags diag.Diagnostics
+	return diags
+}
+
+func resourceProjectDefectTypeUpdate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	projectName := data.Get("project_name").(string)
+	subTypeId, err := strconv.Atoi(data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	subType := rpClient.IssueSubType{
+		Id:        subTypeId,
+		Locator:   data.Get("locator").(string),
+		TypeRef:   data.Get("type_group").(string),
+		LongName:  data.Get("long_name").(string),
+		ShortName: data.Get("short_name").(string),
+		Color:     data.Get("color").(string),
+	}
+	err = c.UpdateIssueSubType(projectName, &subType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceProjectDefectTypeRead(ctx, data, i)
+}
+
+func resourceProjectDefectTypeDelete(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	projectName := data.Get("project_name").(string)
+	subTypeId, err := strconv.Atoi(data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = c.DeleteIssueSubType(projectName, subTypeId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"regexp"
+	"testing"
+)
+
+func TestAccResourceProjectDefectType_basic(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckProjectDefectTypeDestroy(fake, "reportportal_project_defect_type.test"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceProjectDefectTypeConfig(fake, "Environment Issue", "EI", "#0274d1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectDefectTypeExists(fake, "reportportal_project_defect_type.test"),
+					resource.TestCheckResourceAttr("reportportal_project_defect_type.test", "type_group", "SYSTEM_ISSUE"),
+					resource.TestCheckResourceAttr("reportportal_project_defect_type.test", "long_name", "Environment Issue"),
+					resource.TestCheckResourceAttr("reportportal_project_defect_type.test", "short_name", "EI"),
+					resource.TestCheckResourceAttrSet("reportportal_project_defect_type.test", "locator"),
+					resource.TestMatchResourceAttr("reportportal_project_defect_type.test", "content_field", regexp.MustCompile(`^statistics\$defects\$system_issue\$`)),
+				),
+			},
+			{
+				Config: testAccResourceProjectDefectTypeConfig(fake, "Infrastructure Issue", "II", "#a1b2c3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectDefectTypeExists(fake, "reportportal_project_defect_type.test"),
+					resource.TestCheckResourceAttr("reportportal_project_defect_type.test", "long_name", "Infrastructure Issue"),
+					resource.TestCheckResourceAttr("reportportal_project_defect_type.test", "short_name", "II"),
+					resource.TestCheckResourceAttr("reportportal_project_defect_type.test", "color", "#a1b2c3"),
+				),
+			},
+			{
+				ResourceName:      "reportportal_project_defect_type.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccProjectScopedImportId("reportportal_project_defect_type.test"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceProjectDefectType_disappears(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckProjectDefectTypeDestroy(fake, "reportportal_project_defect_type.test"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceProjectDefectTypeConfig(fake, "Environment Issue", "EI", "#0274d1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectDefectTypeExists(fake, "reportportal_project_defect_type.test"),
+					func(s *terraform.State) error {
+						id, err := testAccResourceId(s, "reportportal_project_defect_type.test")
+						if err != nil {
+							return err
+						}
+						fake.removeIssueSubType(id)
+						return nil
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccResourceProjectDefectTypeConfig(fake, "Environment Issue", "EI", "#0274d1"),
+				Check:  testAccCheckProjectDefectTypeExists(fake, "reportportal_project_defect_type.test"),
+			},
+		},
+	})
+}
+
+func testAccCheckProjectDefectTypeExists(fake *fakeReportPortal, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id, err := testAccResourceId(s, resourceName)
+		if err != nil {
+			return err
+		}
+		if !fake.hasIssueSubType(id) {
+			return fmt.Errorf("defect type %d not found", id)
+		}
+		return nil
+	}
+}
+
+func testAccCheckProjectDefectTypeDestroy(fake *fakeReportPortal, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id, err := testAccResourceId(s, resourceName)
+		if err != nil {
+			return nil
+		}
+		if fake.hasIssueSubType(id) {
+			return fmt.Errorf("defect type %d still exists", id)
+		}
+		return nil
+	}
+}
+
+func testAccResourceProjectDefectTypeConfig(fake *fakeReportPortal, longName, shortName, color string) string {
+	return testAccProviderConfig(fake) + fmt.Sprintf(`
+resource "reportportal_project" "test" {
+  name = "tf_acc_defect_type"
+}
+
+resource "reportportal_project_defect_type" "test" {
+  project_name = reportportal_project.test.name
+  type_group   = "SYSTEM_ISSUE"
+  long_name    = %q
+  short_name   = %q
+  color        = %q
+}
+`, longName, shortName, color)
+}
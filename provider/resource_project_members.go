@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	rpClient "github.com/rmalveis/report-portal-client-go/client"
+	"log"
+)
+
+// resourceProjectMembers manages the whole membership of a project: users assigned
+// to the project but not listed in the configuration are unassigned. The account the
+// provider authenticates as is never unassigned, so Terraform keeps access to the project.
+func resourceProjectMembers() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceProjectMembersCreate,
+		ReadContext:   resourceProjectMembersRead,
+		UpdateContext: resourceProjectMembersUpdate,
+		DeleteContext: resourceProjectMembersDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"member": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"login": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"role": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(rpClient.ProjectRoles, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceProjectMembersCreate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	projectName := data.Get("project_name").(string)
+
+	if err := syncProjectMembers(i.(*rpClient.Client), projectName, getProjectMembers(data)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(projectName)
+
+	return resourceProjectMembersRead(ctx, data, i)
+}
+
+func resourceProjectMembersRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	projectName := data.Id()
+
+	users, err := c.GetAllProjectUsers(projectName)
+	if err != nil {
+		if rpClient.IsNotFound(err) {
+			log.Printf("[WARN] ReportPortal project %s not found, removing its members from state", projectName)
+			data.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	members := make([]map[string]interface{}, 0, len(users))
+	for _, user := range users {
+		members = append(members, map[string]interface{}{
+			"login": user.UserId,
+			"role":  user.AssignedProjects[projectName].ProjectRole,
+		})
+	}
+
+	data.Set("project_name", projectName)
+	if err := data.Set("member", members); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var diags diag.Diagnostics
+	return diags
+}
+
+func resourceProjectMembersUpdate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	if err := syncProjectMembers(i.(*rpClient.Client), data.Id(), getProjectMembers(data)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceProjectMembersRead(ctx, data, i)
+}
+
+func resourceProjectMembersDelete(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c := i.(*rpClient.Client)
+
+	caller, err := c.GetCurrentUser()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	members := getProjectMembers(data)
+	logins := make([]string, 0, len(members))
+	for login := range members {
+		if login == caller.UserId {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Provider account left in the project",
+				Detail: fmt.Sprintf("%s is the account the provider authenticates as, it stays assigned to project %s.",
+					caller.UserId, data.Id()),
+			})
+			continue
+		}
+		logins = append(logins, login)
+	}
+
+	if len(logins) == 0 {
+		return diags
+	}
+
+	err = c.UnassignProjectUsers(data.Id(), logins)
+	if err != nil && !rpClient.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// getProjectMembers returns the configured roles keyed by user login.
+func getProjectMembers(data *schema.ResourceData) map[string]string {
+	members := make(map[string]string)
+	for _, m := range data.Get("member").(*schema.Set).List() {
+		member := m.(map[string]interface{})
+		members[member["login"].(string)] = member["role"].(string)
+	}
+	return members
+}
+
+// syncProjectMembers assigns, re-roles and unassigns users until the project membership matches the given roles.
+func syncProjectMembers(c *rpClient.Client, projectName string, members map[string]string) error {
+	users, err := c.GetAllProjectUsers(projectName)
+	if err != nil {
+		return err
+	}
+
+	toAssign := make(map[string]string)
+	toUpdate := make(map[string]string)
+	toUnassign := make([]string, 0)
+
+	current := make(map[string]string)
+	for _, user := range users {
+		current[user.UserId] = user.AssignedProjects[projectName].ProjectRole
+		if _, ok := members[user.UserId]; !ok {
+			toUnassign = append(toUnassign, user.UserId)
+		}
+	}
+
+	if len(toUnassign) > 0 {
+		caller, err := c.GetCurrentUser()
+		if err != nil {
+			return err
+		}
+		for _, login := range toUnassign {
+			if login == caller.UserId {
+				return fmt.Errorf("refusing to unassign %s from project %s: it is the account the provider authenticates as, "+
+					"add it as a member to keep the provider able to manage the project", login, projectName)
+			}
+		}
+	}
+
+	for login, role := range members {
+		currentRole, ok := current[login]
+		if !ok {
+			toAssign[login] = role
+		} else if currentRole != role {
+			toUpdate[login] = role
+		}
+	}
+
+	if len(toAssign) > 0 {
+		if err := c.AssignProjectUsers(projectName, toAssign); err != nil {
+			return err
+		}
+	}
+	if len(toUpdate) > 0 {
+		if err := c.UpdateProjectUserRoles(projectName, toUpdate); err != nil {
+			return err
+		}
+	}
+	if len(toUnassign) > 0 {
+		if err := c.UnassignProjectUsers(projectName, toUnassign); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccResourceProjectMembers_basic(t *testing.T) {
+	fake := newFakeReportPortal(t)
+	fake.addUser("jdoe")
+	fake.addUser("outsider")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckProjectUserDestroy(fake, "tf_acc_project_members", "jdoe"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceProjectMembersConfig(fake, "MEMBER"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectUserRole(fake, "tf_acc_project_members", fakeUsername, "PROJECT_MANAGER"),
+					testAccCheckProjectUserRole(fake, "tf_acc_project_members", "jdoe", "MEMBER"),
+					resource.TestCheckResourceAttr("reportportal_project_members.test", "member.#", "2"),
+				),
+			},
+			{
+				PreConfig: func() {
+					fake.assignProjectMember("tf_acc_project_members", "outsider", "CUSTOMER")
+				},
+				Config: testAccResourceProjectMembersConfig(fake, "OPERATOR"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectUserRole(fake, "tf_acc_project_members", "jdoe", "OPERATOR"),
+					testAccCheckProjectUserRole(fake, "tf_acc_project_members", "outsider", ""),
+					resource.TestCheckResourceAttr("reportportal_project_members.test", "member.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "reportportal_project_members.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceProjectMembers_keepsProviderAccount(t *testing.T) {
+	fake := newFakeReportPortal(t)
+	fake.addUser("jdoe")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceProjectMembersWithoutProviderAccountConfig(fake),
+				ExpectError: regexp.MustCompile(`refusing to unassign ` + fakeUsername + ` from project tf_acc_project_members`),
+			},
+			{
+				Config: testAccResourceProjectMembersConfig(fake, "MEMBER"),
+				Check:  testAccCheckProjectUserRole(fake, "tf_acc_project_members", "jdoe", "MEMBER"),
+			},
+			{
+				// removing the resource unassigns the members but the provider account
+				Config: testAccProviderConfig(fake) + `
+resource "reportportal_project" "test" {
+  name = "tf_acc_project_members"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectUserRole(fake, "tf_acc_project_members", "jdoe", ""),
+					testAccCheckProjectUserRole(fake, "tf_acc_project_members", fakeUsername, "PROJECT_MANAGER"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceProjectMembersConfig(fake *fakeReportPortal, role string) string {
+	return testAccProviderConfig(fake) + fmt.Sprintf(`
+resource "reportportal_project" "test" {
+  name = "tf_acc_project_members"
+}
+
+resource "reportportal_project_members" "test" {
+  project_name = reportportal_project.test.name
+
+  member {
+    login = %q
+    role  = "PROJECT_MANAGER"
+  }
+
+  member {
+    login = "jdoe"
+    role  = %q
+  }
+}
+`, fakeUsername, role)
+}
+
+func testAccResourceProjectMembersWithoutProviderAccountConfig(fake *fakeReportPortal) string {
+	return testAccProviderConfig(fake) + `
+resource "reportportal_project" "test" {
+  name = "tf_acc_project_members"
+}
+
+resource "reportportal_project_members" "test" {
+  project_name = reportportal_project.test.name
+
+  member {
+    login = "jdoe"
+    role  = "MEMBER"
+  }
+}
+`
+}
@@ -0,0 +1,243 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	rpClient "github.com/rmalveis/report-portal-client-go/client"
+	"log"
+)
+
+// resourceProjectNotifications manages all the e-mail notification rules of a project at once.
+func resourceProjectNotifications() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceProjectNotificationsCreate,
+		ReadContext:   resourceProjectNotificationsRead,
+		UpdateContext: resourceProjectNotificationsUpdate,
+		DeleteContext: resourceProjectNotificationsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"send_case": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(rpClient.NotificationSendCases, false),
+						},
+						"notify_owner": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"recipients": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"launch_names": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"attributes_operator": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "AND",
+							ValidateFunc: validation.StringInSlice(rpClient.NotificationAttributesOperators, false),
+						},
+						"attribute": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceProjectNotificationsCreate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	projectName := data.Get("project_name").(string)
+
+	err := c.UpdateProjectNotifications(projectName, mapToProjectNotifications(data))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(projectName)
+
+	return resourceProjectNotificationsRead(ctx, data, i)
+}
+
+func resourceProjectNotificationsRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	projectName := data.Id()
+
+	notifications, err := c.GetProjectNotifications(projectName)
+	if err != nil {
+		if rpClient.IsNotFound(err) {
+			log.Printf("[WARN] ReportPortal project %s not found, removing its notifications from state", projectName)
+			data.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	data.Set("project_name", projectName)
+	data.Set("enabled", notifications.Enabled)
+	if err := data.Set("rule", notificationRulesToMap(notifications.Cases)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var diags diag.Diagnostics
+	return diags
+}
+
+func resourceProjectNotificationsUpdate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	err := c.UpdateProjectNotifications(data.Id(), mapToProjectNotifications(data))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceProjectNotificationsRead(ctx, data, i)
+}
+
+func resourceProjectNotificationsDelete(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	err := c.UpdateProjectNotifications(data.Id(), &rpClient.ProjectNotifications{
+		Enabled: false,
+		Cases:   []rpClient.NotificationRule{},
+	})
+	if err != nil && !rpClient.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func mapToProjectNotifications(data *schema.ResourceData) *rpClient.ProjectNotifications {
+	rules := data.Get("rule").([]interface{})
+	cases := make([]rpClient.NotificationRule, 0, len(rules))
+	for _, r := range rules {
+		rule := r.(map[string]interface{})
+
+		recipients := toStringSlice(rule["recipients"].([]interface{}))
+		if rule["notify_owner"].(bool) {
+			recipients = append([]string{rpClient.NotificationRecipientOwner}, recipients...)
+		}
+
+		attributes := make([]rpClient.ItemAttribute, 0)
+		for _, a := range rule["attribute"].([]interface{}) {
+			attribute := a.(map[string]interface{})
+			attributes = append(attributes, rpClient.ItemAttribute{
+				Key:   attribute["key"].(string),
+				Value: attribute["value"].(string),
+			})
+		}
+
+		cases = append(cases, rpClient.NotificationRule{
+			RuleName:           rule["name"].(string),
+			Enabled:            rule["enabled"].(bool),
+			SendCase:           rule["send_case"].(string),
+			Recipients:         recipients,
+			LaunchNames:        toStringSlice(rule["launch_names"].([]interface{})),
+			Attributes:         attributes,
+			AttributesOperator: rule["attributes_operator"].(string),
+		})
+	}
+
+	return &rpClient.ProjectNotifications{
+		Enabled: data.Get("enabled").(bool),
+		Cases:   cases,
+	}
+}
+
+func notificationRulesToMap(cases []rpClient.NotificationRule) []map[string]interface{} {
+	rules := make([]map[string]interface{}, 0, len(cases))
+	for _, notificationCase := range cases {
+		notifyOwner := false
+		recipients := make([]string, 0, len(notificationCase.Recipients))
+		for _, recipient := range notificationCase.Recipients {
+			if recipient == rpClient.NotificationRecipientOwner {
+				notifyOwner = true
+				continue
+			}
+			recipients = append(recipients, recipient)
+		}
+
+		attributes := make([]map[string]interface{}, 0, len(notificationCase.Attributes))
+		for _, attribute := range notificationCase.Attributes {
+			attributes = append(attributes, map[string]interface{}{
+				"key":   attribute.Key,
+				"value": attribute.Value,
+			})
+		}
+
+		rules = append(rules, map[string]interface{}{
+			"name":                notificationCase.RuleName,
+			"enabled":             notificationCase.Enabled,
+			"send_case":           notificationCase.SendCase,
+			"notify_owner":        notifyOwner,
+			"recipients":          recipients,
+			"launch_names":        notificationCase.LaunchNames,
+			"attributes_operator": notificationCase.AttributesOperator,
+			"attribute":           attributes,
+		})
+	}
+	return rules
+}
+
+func toStringSlice(values []interface{}) []string {
+	r := make([]string, len(values), len(values))
+	for i, v := range values {
+		r[i] = v.(string)
+	}
+	return r
+}
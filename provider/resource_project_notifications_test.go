@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"testing"
+)
+
+func TestAccResourceProjectNotifications_basic(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckProjectNotificationsDestroy(fake, "tf_acc_notifications"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceProjectNotificationsConfig(fake, true, "FAILED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectNotificationsCount(fake, "tf_acc_notifications", 2),
+					func(s *terraform.State) error {
+						rule := fake.projectNotifications("tf_acc_notifications")[0]
+						if len(rule.Recipients) != 2 || rule.Recipients[0] != "OWNER" {
+							return fmt.Errorf("unexpected recipients %v", rule.Recipients)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr("reportportal_project_notifications.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("reportportal_project_notifications.test", "rule.#", "2"),
+					resource.TestCheckResourceAttr("reportportal_project_notifications.test", "rule.0.notify_owner", "true"),
+					resource.TestCheckResourceAttr("reportportal_project_notifications.test", "rule.0.recipients.#", "1"),
+					resource.TestCheckResourceAttr("reportportal_project_notifications.test", "rule.0.send_case", "FAILED"),
+					resource.TestCheckResourceAttr("reportportal_project_notifications.test", "rule.0.attribute.0.key", "env"),
+					resource.TestCheckResourceAttr("reportportal_project_notifications.test", "rule.1.attributes_operator", "AND"),
+				),
+			},
+			{
+				Config: testAccResourceProjectNotificationsConfig(fake, false, "TO_INVESTIGATE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectNotificationsCount(fake, "tf_acc_notifications", 2),
+					resource.TestCheckResourceAttr("reportportal_project_notifications.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("reportportal_project_notifications.test", "rule.0.send_case", "TO_INVESTIGATE"),
+				),
+			},
+			{
+				ResourceName:      "reportportal_project_notifications.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckProjectNotificationsCount(fake *fakeReportPortal, projectName string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if rules := fake.projectNotifications(projectName); len(rules) != count {
+			return fmt.Errorf("expected %d notification rules in project %s, got %d", count, projectName, len(rules))
+		}
+		return nil
+	}
+}
+
+func testAccCheckProjectNotificationsDestroy(fake *fakeReportPortal, projectName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if rules := fake.projectNotifications(projectName); len(rules) != 0 {
+			return fmt.Errorf("project %s still has %d notification rules", projectName, len(rules))
+		}
+		return nil
+	}
+}
+
+func testAccResourceProjectNotificationsConfig(fake *fakeReportPortal, enabled bool, sendCase string) string {
+	return testAccProviderConfig(fake) + fmt.Sprintf(`
+resource "reportportal_project" "test" {
+  name = "tf_acc_notifications"
+}
+
+resource "reportportal_project_notifications" "test" {
+  project_name = reportportal_project.test.name
+  enabled      = %t
+
+  rule {
+    name                = "Nightly failures"
+    send_case           = %q
+    notify_owner        = true
+    recipients          = ["qa-team@example.com"]
+    launch_names        = ["nightly"]
+    attributes_operator = "OR"
+
+    attribute {
+      key   = "env"
+      value = "staging"
+    }
+  }
+
+  rule {
+    name       = "Release"
+    enabled    = false
+    send_case  = "ALWAYS"
+    recipients = ["release@example.com"]
+  }
+}
+`, enabled, sendCase)
+}
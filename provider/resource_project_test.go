@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	rpClient "github.com/rmalveis/report-portal-client-go/client"
+	"testing"
+)
+
+func TestAccResourceProject_basic(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckProjectDestroy(fake, "tf_acc_project", "tf_acc_project_renamed"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceProjectConfig(fake, "tf_acc_project"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectExists(fake, "tf_acc_project"),
+					resource.TestCheckResourceAttr("reportportal_project.test", "name", "tf_acc_project"),
+					resource.TestCheckResourceAttr("reportportal_project.test", "entry_type", "INTERNAL"),
+					resource.TestCheckResourceAttr("reportportal_project.test", "users_quantity", "1"),
+				),
+			},
+			{
+				ResourceName:      "reportportal_project.test",
+				ImportState:       true,
+				ImportStateId:     "tf_acc_project",
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResourceProjectConfig(fake, "tf_acc_project_renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectExists(fake, "tf_acc_project_renamed"),
+					resource.TestCheckResourceAttr("reportportal_project.test", "name", "tf_acc_project_renamed"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceProject_configuration(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckProjectDestroy(fake, "tf_acc_project"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceProjectConfig(fake, "tf_acc_project"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("reportportal_project.test", "interrupted_launch_timeout", "86400"),
+					resource.TestCheckResourceAttr("reportportal_project.test", "keep_logs", "7776000"),
+					resource.TestCheckResourceAttr("reportportal_project.test", "auto_analysis_enabled", "true"),
+				),
+			},
+			{
+				Config: testAccResourceProjectConfigurationConfig(fake, 3600, 1209600, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectAttribute(fake, "tf_acc_project", rpClient.ProjectAttributeInterruptJobTime, "3600"),
+					testAccCheckProjectAttribute(fake, "tf_acc_project", rpClient.ProjectAttributeKeepLogs, "1209600"),
+					testAccCheckProjectAttribute(fake, "tf_acc_project", rpClient.ProjectAttributeKeepLaunches, "0"),
+					testAccCheckProjectAttribute(fake, "tf_acc_project", rpClient.ProjectAttributeAutoAnalyzerEnabled, "false"),
+					testAccCheckProjectAttribute(fake, "tf_acc_project", rpClient.ProjectAttributePatternAnalysisEnabled, "true"),
+					resource.TestCheckResourceAttr("reportportal_project.test", "keep_launches", "0"),
+					resource.TestCheckResourceAttr("reportportal_project.test", "auto_analysis_enabled", "false"),
+				),
+			},
+			{
+				Config: testAccResourceProjectConfigurationConfig(fake, 21600, 2592000, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectAttribute(fake, "tf_acc_project", rpClient.ProjectAttributeInterruptJobTime, "21600"),
+					testAccCheckProjectAttribute(fake, "tf_acc_project", rpClient.ProjectAttributeKeepLogs, "2592000"),
+					testAccCheckProjectAttribute(fake, "tf_acc_project", rpClient.ProjectAttributeAutoAnalyzerEnabled, "true"),
+				),
+			},
+			{
+				ResourceName:      "reportportal_project.test",
+				ImportState:       true,
+				ImportStateId:     "tf_acc_project",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceProject_disappears(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckProjectDestroy(fake, "tf_acc_project"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceProjectConfig(fake, "tf_acc_project"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectExists(fake, "tf_acc_project"),
+					func(*terraform.State) error {
+						fake.removeProject("tf_acc_project")
+						return nil
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccResourceProjectConfig(fake, "tf_acc_project"),
+				Check:  testAccCheckProjectExists(fake, "tf_acc_project"),
+			},
+		},
+	})
+}
+
+func testAccCheckProjectExists(fake *fakeReportPortal, name string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if !fake.hasProject(name) {
+			return fmt.Errorf("project %s not found", name)
+		}
+		return nil
+	}
+}
+
+func testAccCheckProjectAttribute(fake *fakeReportPortal, name, attribute, expected string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if actual := fake.projectAttribute(name, attribute); actual != expected {
+			return fmt.Errorf("project %s attribute %s: expected %q, got %q", name, attribute, expected, actual)
+		}
+		return nil
+	}
+}
+
+func testAccCheckProjectDestroy(fake *fakeReportPortal, names ...string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		for _, name := range names {
+			if fake.hasProject(name) {
+				return fmt.Errorf("project %s still exists", name)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccResourceProjectConfig(fake *fakeReportPortal, name string) string {
+	return testAccProviderConfig(fake) + fmt.Sprintf(`
+resource "reportportal_project" "test" {
+  name = %q
+}
+`, name)
+}
+
+func testAccResourceProjectConfigurationConfig(fake *fakeReportPortal, interruptTimeout, keepLogs int, autoAnalysis bool) string {
+	return testAccProviderConfig(fake) + fmt.Sprintf(`
+resource "reportportal_project" "test" {
+  name                       = "tf_acc_project"
+  interrupted_launch_timeout = %d
+  keep_launches              = 0
+  keep_logs                  = %d
+  keep_screenshots           = 604800
+  auto_analysis_enabled      = %t
+  pattern_analysis_enabled   = true
+}
+`, interruptTimeout, keepLogs, autoAnalysis)
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	rpClient "github.com/rmalveis/report-portal-client-go/client"
+	"log"
+	"strings"
+)
+
+func resourceProjectUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceProjectUserCreate,
+		ReadContext:   resourceProjectUserRead,
+		UpdateContext: resourceProjectUserUpdate,
+		DeleteContext: resourceProjectUserDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"login": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(rpClient.ProjectRoles, false),
+			},
+		},
+	}
+}
+
+// parseProjectUserId splits an ID in the <project_name>/<login> format.
+func parseProjectUserId(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected ID %q, expected <project_name>/<login>", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func resourceProjectUserCreate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	projectName := data.Get("project_name").(string)
+	login := data.Get("login").(string)
+
+	err := c.AssignProjectUsers(projectName, map[string]string{login: data.Get("role").(string)})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(fmt.Sprintf("%s/%s", projectName, login))
+
+	return resourceProjectUserRead(ctx, data, i)
+}
+
+func resourceProjectUserRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	projectName, login, err := parseProjectUserId(data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	user, err := c.GetProjectUser(projectName, login)
+	if err != nil {
+		if rpClient.IsNotFound(err) {
+			log.Printf("[WARN] ReportPortal user %s not assigned to project %s, removing it from state", login, projectName)
+			data.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	data.Set("project_name", projectName)
+	data.Set("login", user.UserId)
+	data.Set("role", user.AssignedProjects[projectName].ProjectRole)
+
+	var diags diag.Diagnostics
+	return diags
+}
+
+func resourceProjectUserUpdate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	projectName := data.Get("project_name").(string)
+	login := data.Get("login").(string)
+
+	err := c.UpdateProjectUserRoles(projectName, map[string]string{login: data.Get("role").(string)})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceProjectUserRead(ctx, data, i)
+}
+
+func resourceProjectUserDelete(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	projectName := data.Get("project_name").(string)
+	login := data.Get("login").(string)
+
+	err := c.UnassignProjectUsers(projectName, []string{login})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
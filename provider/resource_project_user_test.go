@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"testing"
+)
+
+func TestAccResourceProjectUser_basic(t *testing.T) {
+	fake := newFakeReportPortal(t)
+	fake.addUser("jdoe")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckProjectUserDestroy(fake, "tf_acc_project_user", "jdoe"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceProjectUserConfig(fake, "MEMBER"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectUserRole(fake, "tf_acc_project_user", "jdoe", "MEMBER"),
+					resource.TestCheckResourceAttr("reportportal_project_user.test", "id", "tf_acc_project_user/jdoe"),
+					resource.TestCheckResourceAttr("reportportal_project_user.test", "role", "MEMBER"),
+				),
+			},
+			{
+				Config: testAccResourceProjectUserConfig(fake, "PROJECT_MANAGER"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectUserRole(fake, "tf_acc_project_user", "jdoe", "PROJECT_MANAGER"),
+					resource.TestCheckResourceAttr("reportportal_project_user.test", "role", "PROJECT_MANAGER"),
+				),
+			},
+			{
+				ResourceName:      "reportportal_project_user.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceProjectUser_disappears(t *testing.T) {
+	fake := newFakeReportPortal(t)
+	fake.addUser("jdoe")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckProjectUserDestroy(fake, "tf_acc_project_user", "jdoe"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceProjectUserConfig(fake, "MEMBER"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectUserRole(fake, "tf_acc_project_user", "jdoe", "MEMBER"),
+					func(s *terraform.State) error {
+						fake.unassignProjectMember("tf_acc_project_user", "jdoe")
+						return nil
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccResourceProjectUserConfig(fake, "MEMBER"),
+				Check:  testAccCheckProjectUserRole(fake, "tf_acc_project_user", "jdoe", "MEMBER"),
+			},
+		},
+	})
+}
+
+func testAccCheckProjectUserRole(fake *fakeReportPortal, projectName, login, role string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if actual := fake.projectMemberRole(projectName, login); actual != role {
+			return fmt.Errorf("expected user %s to have role %q in project %s, got %q", login, role, projectName, actual)
+		}
+		return nil
+	}
+}
+
+func testAccCheckProjectUserDestroy(fake *fakeReportPortal, projectName, login string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if role := fake.projectMemberRole(projectName, login); role != "" {
+			return fmt.Errorf("user %s is still assigned to project %s", login, projectName)
+		}
+		return nil
+	}
+}
+
+func testAccResourceProjectUserConfig(fake *fakeReportPortal, role string) string {
+	return testAccProviderConfig(fake) + fmt.Sprintf(`
+resource "reportportal_project" "test" {
+  name = "tf_acc_project_user"
+}
+
+resource "reportportal_project_user" "test" {
+  project_name = reportportal_project.test.name
+  login        = "jdoe"
+  role         = %q
+}
+`, role)
+}
@@ -0,0 +1,250 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	rpClient "github.com/rmalveis/report-portal-client-go/client"
+	"log"
+	"strconv"
+)
+
+func resourceUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUserCreate,
+		ReadContext:   resourceUserRead,
+		UpdateContext: resourceUserUpdate,
+		DeleteContext: resourceUserDelete,
+		CustomizeDiff: resourceUserCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUserImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"login": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"full_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"account_role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "USER",
+				ValidateFunc: validation.StringInSlice(rpClient.AccountRoles, false),
+			},
+			// ReportPortal neither returns the password nor lets an administrator change it,
+			// so it is only sent on creation and later changes are rejected
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"default_project": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"project_role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "MEMBER",
+				ValidateFunc: validation.StringInSlice(rpClient.ProjectRoles, false),
+			},
+			"account_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// resourceUserCustomizeDiff rejects password changes during the plan, as they cannot be applied.
+// The password of an imported user is unknown, so the configured one is only recorded.
+func resourceUserCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("password") {
+		return nil
+	}
+
+	if oldPassword, _ := diff.GetChange("password"); oldPassword.(string) != "" {
+		return fmt.Errorf("the password of user %q cannot be changed: ReportPortal only lets users change their own password. "+
+			"To record a password changed in ReportPortal, remove the user from the state and import it again", diff.Get("login").(string))
+	}
+	return nil
+}
+
+func resourceUserCreate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	login := data.Get("login").(string)
+
+	user, err := c.CreateUser(&rpClient.CreateUserRequest{
+		Login:          login,
+		Password:       data.Get("password").(string),
+		FullName:       data.Get("full_name").(string),
+		Email:          data.Get("email").(string),
+		AccountRole:    data.Get("account_role").(string),
+		ProjectRole:    data.Get("project_role").(string),
+		DefaultProject: data.Get("default_project").(string),
+	})
+	if err != nil {
+		if rpClient.IsConflict(err) {
+			return diag.Errorf("user %q already exists, use terraform import to manage it: %s", login, err)
+		}
+		return diag.FromErr(err)
+	}
+
+	data.SetId(strconv.Itoa(user.Id))
+
+	return resourceUserRead(ctx, data, i)
+}
+
+func resourceUserRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	login := data.Get("login").(string)
+
+	user, err := c.GetUser(login)
+	if err != nil {
+		if rpClient.IsNotFound(err) {
+			log.Printf("[WARN] ReportPortal user %s not found, removing it from state", login)
+			data.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	data.Set("login", user.UserId)
+	data.Set("email", user.Email)
+	data.Set("full_name", user.FullName)
+	data.Set("account_role", user.UserRole)
+	data.Set("account_type", user.AccountType)
+
+	// the default project is the project the user was assigned to on creation, an imported
+	// user only gets one when it is assigned to a single project
+	defaultProject := data.Get("default_project").(string)
+	if defaultProject == "" && len(user.AssignedProjects) == 1 {
+		for projectName := range user.AssignedProjects {
+			defaultProject = projectName
+		}
+	}
+	if assignedProject, ok := user.AssignedProjects[defaultProject]; ok {
+		data.Set("default_project", defaultProject)
+		data.Set("project_role", assignedProject.ProjectRole)
+	} else {
+		data.Set("default_project", "")
+	}
+
+	data.SetId(strconv.Itoa(user.Id))
+
+	var diags diag.Diagnostics
+	return diags
+}
+
+func resourceUserUpdate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	var diags diag.Diagnostics
+
+	login := data.Get("login").(string)
+
+	if data.HasChanges("email", "full_name", "account_role") {
+		err := c.UpdateUser(login, &rpClient.UpdateUserRequest{
+			Email:    data.Get("email").(string),
+			FullName: data.Get("full_name").(string),
+			Role:     data.Get("account_role").(string),
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if data.HasChanges("default_project", "project_role") {
+		if err := updateUserDefaultProject(c, data); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// only an imported user can get here with a password change, see resourceUserCustomizeDiff
+	if data.HasChange("password") {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Password not changed",
+			Detail:   fmt.Sprintf("The password of the imported user %q is recorded in the state but not sent to ReportPortal.", login),
+		})
+	}
+
+	return append(diags, resourceUserRead(ctx, data, i)...)
+}
+
+// updateUserDefaultProject moves the user to its new default project, or changes its role in
+// the current one, through the project assignment API.
+func updateUserDefaultProject(c *rpClient.Client, data *schema.ResourceData) error {
+	login := data.Get("login").(string)
+	role := data.Get("project_role").(string)
+	oldProject, newProject := data.GetChange("default_project")
+
+	user, err := c.GetUser(login)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := user.AssignedProjects[newProject.(string)]; ok {
+		err = c.UpdateProjectUserRoles(newProject.(string), map[string]string{login: role})
+	} else {
+		err = c.AssignProjectUsers(newProject.(string), map[string]string{login: role})
+	}
+	if err != nil {
+		return err
+	}
+
+	if oldProject.(string) == "" || oldProject.(string) == newProject.(string) {
+		return nil
+	}
+	if _, ok := user.AssignedProjects[oldProject.(string)]; !ok {
+		return nil
+	}
+
+	return c.UnassignProjectUsers(oldProject.(string), []string{login})
+}
+
+func resourceUserDelete(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*rpClient.Client)
+
+	userId, err := strconv.Atoi(data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = c.DeleteUser(userId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceUserImport(ctx context.Context, data *schema.ResourceData, i interface{}) ([]*schema.ResourceData, error) {
+	c := i.(*rpClient.Client)
+
+	login := data.Id()
+
+	user, err := c.GetUser(login)
+	if err != nil {
+		return nil, err
+	}
+
+	data.SetId(strconv.Itoa(user.Id))
+	if err = data.Set("login", login); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{data}, nil
+}
@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"regexp"
+	"testing"
+)
+
+func TestAccResourceUser_basic(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckUserDestroy(fake, "tf_acc_runner"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUserConfig(fake, "Test Runner", "USER"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(fake, "tf_acc_runner"),
+					testAccCheckProjectUserRole(fake, "tf_acc_user", "tf_acc_runner", "MEMBER"),
+					func(s *terraform.State) error {
+						if password := fake.userPassword("tf_acc_runner"); password != "s3cr3t-Passw0rd" {
+							return fmt.Errorf("unexpected password %q", password)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr("reportportal_user.test", "full_name", "Test Runner"),
+					resource.TestCheckResourceAttr("reportportal_user.test", "account_role", "USER"),
+					resource.TestCheckResourceAttr("reportportal_user.test", "account_type", "INTERNAL"),
+				),
+			},
+			{
+				Config: testAccResourceUserConfig(fake, "Nightly Runner", "ADMINISTRATOR"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(fake, "tf_acc_runner"),
+					resource.TestCheckResourceAttr("reportportal_user.test", "full_name", "Nightly Runner"),
+					resource.TestCheckResourceAttr("reportportal_user.test", "account_role", "ADMINISTRATOR"),
+				),
+			},
+			{
+				ResourceName:            "reportportal_user.test",
+				ImportState:             true,
+				ImportStateId:           "tf_acc_runner",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func TestAccResourceUser_defaultProject(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckUserDestroy(fake, "tf_acc_runner"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUserConfig(fake, "Test Runner", "USER"),
+				Check:  testAccCheckProjectUserRole(fake, "tf_acc_user", "tf_acc_runner", "MEMBER"),
+			},
+			{
+				Config: testAccResourceUserProjectConfig(fake, "Test Runner", "USER", "s3cr3t-Passw0rd", "test", "OPERATOR"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectUserRole(fake, "tf_acc_user", "tf_acc_runner", "OPERATOR"),
+					resource.TestCheckResourceAttr("reportportal_user.test", "project_role", "OPERATOR"),
+				),
+			},
+			{
+				Config: testAccResourceUserProjectConfig(fake, "Test Runner", "USER", "s3cr3t-Passw0rd", "other", "CUSTOMER"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProjectUserRole(fake, "tf_acc_user", "tf_acc_runner", ""),
+					testAccCheckProjectUserRole(fake, "tf_acc_user_other", "tf_acc_runner", "CUSTOMER"),
+					resource.TestCheckResourceAttr("reportportal_user.test", "default_project", "tf_acc_user_other"),
+				),
+			},
+			{
+				// unassigned outside Terraform, the user is assigned again
+				PreConfig: func() {
+					fake.unassignProjectMember("tf_acc_user_other", "tf_acc_runner")
+				},
+				Config: testAccResourceUserProjectConfig(fake, "Test Runner", "USER", "s3cr3t-Passw0rd", "other", "CUSTOMER"),
+				Check:  testAccCheckProjectUserRole(fake, "tf_acc_user_other", "tf_acc_runner", "CUSTOMER"),
+			},
+			{
+				// the password cannot be changed
+				Config:      testAccResourceUserProjectConfig(fake, "Test Runner", "USER", "n3w-Passw0rd", "other", "CUSTOMER"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`the password of user "tf_acc_runner" cannot be changed`),
+			},
+		},
+	})
+}
+
+func TestAccResourceUser_disappears(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckUserDestroy(fake, "tf_acc_runner"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUserConfig(fake, "Test Runner", "USER"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(fake, "tf_acc_runner"),
+					func(s *terraform.State) error {
+						fake.removeUser("tf_acc_runner")
+						return nil
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccResourceUserConfig(fake, "Test Runner", "USER"),
+				Check:  testAccCheckUserExists(fake, "tf_acc_runner"),
+			},
+		},
+	})
+}
+
+func testAccCheckUserExists(fake *fakeReportPortal, login string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !fake.hasUser(login) {
+			return fmt.Errorf("user %s not found", login)
+		}
+		return nil
+	}
+}
+
+func testAccCheckUserDestroy(fake *fakeReportPortal, login string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if fake.hasUser(login) {
+			return fmt.Errorf("user %s still exists", login)
+		}
+		return nil
+	}
+}
+
+func testAccResourceUserConfig(fake *fakeReportPortal, fullName, accountRole string) string {
+	return testAccResourceUserProjectConfig(fake, fullName, accountRole, "s3cr3t-Passw0rd", "test", "MEMBER")
+}
+
+func testAccResourceUserProjectConfig(fake *fakeReportPortal, fullName, accountRole, password, defaultProject, projectRole string) string {
+	return testAccProviderConfig(fake) + fmt.Sprintf(`
+resource "reportportal_project" "test" {
+  name = "tf_acc_user"
+}
+
+resource "reportportal_project" "other" {
+  name = "tf_acc_user_other"
+}
+
+resource "reportportal_user" "test" {
+  login           = "tf_acc_runner"
+  email           = "runner@example.com"
+  full_name       = %q
+  account_role    = %q
+  password        = %q
+  default_project = reportportal_project.%s.name
+  project_role    = %q
+}
+`, fullName, accountRole, password, defaultProject, projectRole)
+}
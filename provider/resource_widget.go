@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	rpClient "github.com/rmalveis/report-portal-client-go/client"
+	"log"
+	"sort"
 	"strconv"
 	"strings"
-	"time"
 )
 
 func resourceWidget() *schema.Resource {
@@ -17,6 +19,9 @@ func resourceWidget() *schema.Resource {
 		ReadContext:   resourceWidgetRead,
 		UpdateContext: resourceWidgetUpdate,
 		DeleteContext: resourceWidgetDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceWidgetImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"project_name": {
 				Type:     schema.TypeString,
@@ -41,9 +46,14 @@ func resourceWidget() *schema.Resource {
 				Default:  true,
 			},
 			"widget_type": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(widgetTypeOptions(), false),
+				// the name and the ReportPortal type of a widget type are interchangeable
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return encodeWidgetTypeOption(old) == encodeWidgetTypeOption(new)
+				},
 			},
 			"widget_type_calculated": {
 				Type:     schema.TypeString,
@@ -90,6 +100,8 @@ func resourceWidget() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			// ReportPortal keeps the action types as a list and the users as a comma separated
+			// string, the attributes keep their original types and are converted
 			"options_action_type": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -109,6 +121,46 @@ func resourceWidget() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"options_attributes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 2,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"options_attribute_keys": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"options_min_passing_rate": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			"options_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"launch", "filter"}, false),
+			},
+			"options_basic_columns": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			// column name -> attribute key
+			"options_custom_columns": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -131,32 +183,44 @@ func resourceWidgetRead(ctx context.Context, data *schema.ResourceData, i interf
 
 	widgetSettings, err := client.ReadFullWidgetDataByProjectName(&pn, &widgetId)
 	if err != nil {
-		if strings.Contains(err.Error(), "404") {
+		if rpClient.IsNotFound(err) {
+			log.Printf("[WARN] ReportPortal widget %s not found in project %s, removing it from state", widgetId, pn)
+			data.SetId("")
 			return diags
 		}
 		return diag.FromErr(err)
 	}
 
-	err = data.Set("project_name", pn)
-	err = data.Set("description", widgetSettings.Description)
-	err = data.Set("name", widgetSettings.Name)
-	err = data.Set("owner", widgetSettings.Owner)
-	err = data.Set("share", widgetSettings.Share)
-	err = data.Set("widget_type_calculated", widgetSettings.WidgetType)
-	err = data.Set("filter_ids", getFilterIds(widgetSettings.AppliedFilters))
-	err = data.Set("parameters_content_fields_calculated", widgetSettings.ContentParameters.ContentFields)
-	err = data.Set("parameters_items_count", widgetSettings.ContentParameters.ItemsCount)
-	err = data.Set("options_latest", widgetSettings.ContentParameters.WidgetOptions["latest"])
-	err = data.Set("options_view_mode", widgetSettings.ContentParameters.WidgetOptions["viewMode"])
-	err = data.Set("options_timeline", widgetSettings.ContentParameters.WidgetOptions["timeline"])
-	err = data.Set("options_zoom", widgetSettings.ContentParameters.WidgetOptions["zoom"])
-	err = data.Set("options_action_type", widgetSettings.ContentParameters.WidgetOptions["actionTypes"])
-	err = data.Set("options_user", widgetSettings.ContentParameters.WidgetOptions["user"])
-	err = data.Set("options_launch_name_filter", widgetSettings.ContentParameters.WidgetOptions["launchNameFilter"])
-	err = data.Set("options_include_methods", widgetSettings.ContentParameters.WidgetOptions["includeMethods"])
-
-	if err != nil {
-		return diag.FromErr(err)
+	options := widgetSettings.ContentParameters.WidgetOptions
+	values := map[string]interface{}{
+		"project_name":                         pn,
+		"description":                          widgetSettings.Description,
+		"name":                                 widgetSettings.Name,
+		"owner":                                widgetSettings.Owner,
+		"share":                                widgetSettings.Share,
+		"widget_type_calculated":               widgetSettings.WidgetType,
+		"filter_ids":                           getFilterIds(widgetSettings.AppliedFilters),
+		"parameters_content_fields_calculated": widgetSettings.ContentParameters.ContentFields,
+		"parameters_items_count":               widgetSettings.ContentParameters.ItemsCount,
+		"options_latest":                       options["latest"],
+		"options_view_mode":                    options["viewMode"],
+		"options_timeline":                     options["timeline"],
+		"options_zoom":                         options["zoom"],
+		"options_action_type":                  strings.Join(widgetOptionStrings(options["actionType"]), ","),
+		"options_user":                         widgetOptionStrings(options["user"]),
+		"options_launch_name_filter":           options["launchNameFilter"],
+		"options_include_methods":              options["includeMethods"],
+		"options_attributes":                   options["attributes"],
+		"options_attribute_keys":               options["attributeKeys"],
+		"options_min_passing_rate":             options["minPassingRate"],
+		"options_strategy":                     options["strategy"],
+		"options_basic_columns":                options["basicColumns"],
+		"options_custom_columns":               options["customColumns"],
+	}
+	for key, value := range values {
+		if err = data.Set(key, value); err != nil {
+			return diag.FromErr(fmt.Errorf("unable to set %s of widget %s: %w", key, widgetId, err))
+		}
 	}
 
 	return diags
@@ -179,9 +243,8 @@ func resourceWidgetCreate(ctx context.Context, data *schema.ResourceData, i inte
 	}
 
 	data.SetId(strconv.Itoa(savedSettings.Id))
-	resourceWidgetRead(ctx, data, i)
 
-	return diags
+	return append(diags, resourceWidgetRead(ctx, data, i)...)
 }
 
 func resourceWidgetUpdate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
@@ -200,37 +263,143 @@ func resourceWidgetUpdate(ctx context.Context, data *schema.ResourceData, i inte
 		return diag.FromErr(err)
 	}
 
-	err = data.Set("latest_updated", time.Now().Format(time.RFC3339))
+	return append(diags, resourceWidgetRead(ctx, data, i)...)
+}
+
+// resourceWidgetImport accepts <project_name>/<widget_id> and restores the user facing
+// widget type and criteria, which the Read function only keeps in their calculated form.
+func resourceWidgetImport(ctx context.Context, data *schema.ResourceData, i interface{}) ([]*schema.ResourceData, error) {
+	client := i.(*rpClient.Client)
+
+	pn, id, err := parseProjectScopedId(data.Id())
+	if err != nil {
+		return nil, err
+	}
+	widgetId := strconv.Itoa(id)
+
+	widgetSettings, err := client.ReadFullWidgetDataByProjectName(&pn, &widgetId)
 	if err != nil {
-		return nil
+		return nil, err
 	}
-	resourceWidgetRead(ctx, data, i)
 
-	return diags
+	data.SetId(widgetId)
+	err = data.Set("project_name", pn)
+	if err != nil {
+		return nil, err
+	}
+
+	err = data.Set("widget_type", decodeWidgetTypeOption(widgetSettings.WidgetType))
+	if err != nil {
+		return nil, err
+	}
+
+	if isCriteriaManagedByUser(widgetSettings.WidgetType) {
+		err = data.Set("parameters_content_fields", getCriteriaNames(widgetSettings.ContentParameters.ContentFields))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return []*schema.ResourceData{data}, nil
 }
 
 // Auxiliary functions
+
+// widgetTypeOptions lists the accepted widget types, either by their name or by their ReportPortal type.
+func widgetTypeOptions() []string {
+	options := make([]string, 0, 2*len(rpClient.WidgetTypes))
+	for name, value := range rpClient.WidgetTypes {
+		options = append(options, name)
+		if value != name {
+			options = append(options, value)
+		}
+	}
+	sort.Strings(options)
+	return options
+}
+
 func encodeWidgetTypeOption(widgetType string) string {
-	return rpClient.WidgetTypes[widgetType]
+	if value, ok := rpClient.WidgetTypes[widgetType]; ok {
+		return value
+	}
+	return widgetType
+}
+
+func decodeWidgetTypeOption(widgetType string) string {
+	for name, value := range rpClient.WidgetTypes {
+		if value == widgetType {
+			return name
+		}
+	}
+	return widgetType
+}
+
+// Content fields of the widgets whose criteria are fixed
+var (
+	executionContentFields = []string{
+		"statistics$executions$total",
+		"statistics$executions$passed",
+		"statistics$executions$failed",
+		"statistics$executions$skipped",
+	}
+	defectTotalContentFields = []string{
+		"statistics$defects$product_bug$total",
+		"statistics$defects$automation_bug$total",
+		"statistics$defects$system_issue$total",
+		"statistics$defects$no_defect$total",
+		"statistics$defects$to_investigate$total",
+	}
+	// fields of the activities listed by the project activity panel
+	activityContentFields = []string{
+		"user",
+		"lastModified",
+		"actionType",
+		"objectType",
+		"objectName",
+		"projectName",
+		"details",
+	}
+)
+
+// isCriteriaManagedByUser reports whether parameters_content_fields is taken from the
+// configuration or fixed by getCriteriaByWidgetType for the given widget type.
+func isCriteriaManagedByUser(widgetType string) bool {
+	switch widgetType {
+	case "launchesDurationChart", "bugTrend", "flakyTestCases", "casesTrend", "notPassed",
+		"investigatedTrend", "uniqueBugTable", "activityStream", "launchesComparisonChart",
+		"passingRateSummary", "passingRatePerLaunch", "mostTimeConsuming", "componentHealthCheck",
+		"topPatternTemplates", "componentHealthCheckTable":
+		return false
+	default:
+		return true
+	}
 }
 
 func getCriteriaByWidgetType(widgetType *string, data *schema.ResourceData) ([]string, error) {
 	switch *widgetType {
 	case "launchesDurationChart":
 		return []string{"startTime", "endTime", "name", "number", "status"}, nil
-	case "bugTrend":
-		return []string{"statistics$defects$product_bug$total",
-			"statistics$defects$automation_bug$total",
-			"statistics$defects$system_issue$total",
-			"statistics$defects$no_defect$total",
-			"statistics$defects$to_investigate$total"}, nil
+	case "activityStream":
+		return activityContentFields, nil
+	case "bugTrend", "investigatedTrend":
+		return defectTotalContentFields, nil
+	case "casesTrend":
+		return []string{"statistics$executions$total"}, nil
+	case "notPassed":
+		return []string{"statistics$executions$failed",
+			"statistics$executions$skipped",
+			"statistics$executions$total"}, nil
+	case "passingRateSummary", "passingRatePerLaunch":
+		return []string{"statistics$executions$passed", "statistics$executions$total"}, nil
+	case "launchesComparisonChart", "componentHealthCheckTable":
+		return append(append([]string{}, executionContentFields...), defectTotalContentFields...), nil
 	case "topTestCases":
 		r := data.Get("parameters_content_fields").([]interface{})
 		if len(r) == 0 || len(r) > 1 {
 			return nil, fmt.Errorf("This Widget Type must have one and only one criteria.(parameters_content_fields)")
 		}
 		return getCriteriaValues(r), nil
-	case "flakyTestCases":
+	case "flakyTestCases", "uniqueBugTable", "mostTimeConsuming", "componentHealthCheck", "topPatternTemplates":
 		return nil, nil
 	default:
 		r := data.Get("parameters_content_fields").([]interface{})
@@ -241,10 +410,54 @@ func getCriteriaByWidgetType(widgetType *string, data *schema.ResourceData) ([]s
 	}
 }
 
+// validateWidgetOptions checks the options some widget types can not be rendered without.
+func validateWidgetOptions(widgetType string, data *schema.ResourceData) error {
+	switch widgetType {
+	case "passingRatePerLaunch", "mostTimeConsuming":
+		if data.Get("options_launch_name_filter").(string) == "" {
+			return fmt.Errorf("This Widget Type requires a launch name (options_launch_name_filter)")
+		}
+	case "cumulative":
+		if len(data.Get("options_attributes").([]interface{})) == 0 {
+			return fmt.Errorf("This Widget Type requires at least one attribute key (options_attributes)")
+		}
+	case "productStatus":
+		if data.Get("options_strategy").(string) == "" {
+			return fmt.Errorf("This Widget Type requires a grouping strategy, launch or filter (options_strategy)")
+		}
+	case "componentHealthCheck", "componentHealthCheckTable":
+		if len(data.Get("options_attribute_keys").([]interface{})) == 0 {
+			return fmt.Errorf("This Widget Type requires at least one attribute key (options_attribute_keys)")
+		}
+	}
+	return nil
+}
+
+// getCriteriaValues translates criteria names to content fields. Unknown names are passed through
+// as raw content fields, so custom defect types can be referenced by their content_field.
 func getCriteriaValues(criteria []interface{}) []string {
 	r := make([]string, len(criteria), len(criteria))
 	for i, c := range criteria {
-		r[i] = rpClient.WidgetCriteria[c.(string)]
+		value, ok := rpClient.WidgetCriteria[c.(string)]
+		if !ok {
+			value = c.(string)
+		}
+		r[i] = value
+	}
+	return r
+}
+
+func getCriteriaNames(contentFields []string) []string {
+	r := make([]string, 0, len(contentFields))
+	for _, cf := range contentFields {
+		name := cf
+		for n, value := range rpClient.WidgetCriteria {
+			if value == cf {
+				name = n
+				break
+			}
+		}
+		r = append(r, name)
 	}
 	return r
 }
@@ -257,12 +470,41 @@ func getFilterIds(filters []rpClient.Filter) []int {
 	return r
 }
 
+// splitWidgetOption splits a comma separated widget option, ignoring blank values.
+func splitWidgetOption(value string) []string {
+	values := make([]string, 0)
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// widgetOptionStrings reads a widget option stored either as a list or as a comma separated string.
+func widgetOptionStrings(value interface{}) []string {
+	switch v := value.(type) {
+	case string:
+		return splitWidgetOption(v)
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			values = append(values, fmt.Sprint(item))
+		}
+		return values
+	}
+	return nil
+}
+
 func getWidgetParameters(data *schema.ResourceData) (*rpClient.WidgetInputPayload, error) {
 	widgetType := encodeWidgetTypeOption(data.Get("widget_type").(string))
 	contentFields, err := getCriteriaByWidgetType(&widgetType, data)
 	if err != nil {
 		return nil, err
 	}
+	if err = validateWidgetOptions(widgetType, data); err != nil {
+		return nil, err
+	}
 
 	var widgetSettings rpClient.WidgetInputPayload
 	widgetSettings.WidgetType = widgetType
@@ -282,10 +524,16 @@ func getWidgetOptions(data *schema.ResourceData) map[string]interface{} {
 	options["timeline"] = data.Get("options_timeline").(string)
 	options["viewMode"] = data.Get("options_view_mode").(string)
 	options["zoom"] = data.Get("options_zoom").(bool)
-	options["actionType"] = data.Get("options_action_type").(string)
-	options["user"] = data.Get("options_user").([]interface{})
+	options["actionType"] = splitWidgetOption(data.Get("options_action_type").(string))
+	options["user"] = strings.Join(toStringSlice(data.Get("options_user").([]interface{})), ",")
 	options["launchNameFilter"] = data.Get("options_launch_name_filter").(string)
 	options["includeMethods"] = data.Get("options_include_methods").(bool)
+	options["attributes"] = data.Get("options_attributes").([]interface{})
+	options["attributeKeys"] = data.Get("options_attribute_keys").([]interface{})
+	options["minPassingRate"] = data.Get("options_min_passing_rate").(int)
+	options["strategy"] = data.Get("options_strategy").(string)
+	options["basicColumns"] = data.Get("options_basic_columns").([]interface{})
+	options["customColumns"] = data.Get("options_custom_columns").(map[string]interface{})
 
 	return options
 }
@@ -0,0 +1,338 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"reflect"
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+func TestAccResourceWidget_basic(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceWidgetConfig(fake, "Overall", `["Total", "Passed", "Failed"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWidgetExists(fake, "reportportal_widget.test"),
+					resource.TestCheckResourceAttr("reportportal_widget.test", "widget_type_calculated", "overallStatistics"),
+					resource.TestCheckResourceAttr("reportportal_widget.test", "owner", fakeUsername),
+					resource.TestCheckResourceAttr("reportportal_widget.test", "parameters_content_fields_calculated.#", "3"),
+					resource.TestCheckResourceAttr("reportportal_widget.test", "parameters_content_fields_calculated.0", "statistics$executions$total"),
+					resource.TestCheckResourceAttrPair("reportportal_widget.test", "filter_ids.0", "reportportal_filter.test", "id"),
+				),
+			},
+			{
+				Config: testAccResourceWidgetConfig(fake, "Overall failures", `["Failed", "Skipped"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWidgetExists(fake, "reportportal_widget.test"),
+					testAccCheckWidgetName(fake, "reportportal_widget.test", "Overall failures"),
+					resource.TestCheckResourceAttr("reportportal_widget.test", "name", "Overall failures"),
+					resource.TestCheckResourceAttr("reportportal_widget.test", "parameters_content_fields_calculated.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "reportportal_widget.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccProjectScopedImportId("reportportal_widget.test"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceWidget_componentHealthCheck(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceWidgetComponentHealthCheckConfig(fake, 80),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWidgetExists(fake, "reportportal_widget.test"),
+					resource.TestCheckResourceAttr("reportportal_widget.test", "widget_type_calculated", "componentHealthCheckTable"),
+					resource.TestCheckResourceAttr("reportportal_widget.test", "parameters_content_fields_calculated.#", "9"),
+					resource.TestCheckResourceAttr("reportportal_widget.test", "parameters_content_fields_calculated.8", "statistics$defects$to_investigate$total"),
+					resource.TestCheckResourceAttr("reportportal_widget.test", "options_attribute_keys.#", "2"),
+					resource.TestCheckResourceAttr("reportportal_widget.test", "options_min_passing_rate", "80"),
+				),
+			},
+			{
+				Config: testAccResourceWidgetComponentHealthCheckConfig(fake, 95),
+				Check:  resource.TestCheckResourceAttr("reportportal_widget.test", "options_min_passing_rate", "95"),
+			},
+			{
+				ResourceName:      "reportportal_widget.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccProjectScopedImportId("reportportal_widget.test"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceWidget_activityStream(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceWidgetActivityConfig(fake, "Project activity panel"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWidgetExists(fake, "reportportal_widget.test"),
+					resource.TestCheckResourceAttr("reportportal_widget.test", "widget_type_calculated", "activityStream"),
+					resource.TestCheckResourceAttr("reportportal_widget.test", "parameters_content_fields_calculated.#", "7"),
+					resource.TestCheckResourceAttr("reportportal_widget.test", "parameters_content_fields_calculated.2", "actionType"),
+					resource.TestCheckResourceAttr("reportportal_widget.test", "options_action_type", "startLaunch,finishLaunch"),
+					resource.TestCheckResourceAttr("reportportal_widget.test", "options_user.#", "2"),
+					resource.TestCheckResourceAttr("reportportal_widget.test", "options_user.1", "bob"),
+					testAccCheckWidgetOptions(fake, "reportportal_widget.test", map[string]interface{}{
+						"actionType": []interface{}{"startLaunch", "finishLaunch"},
+						"user":       "alice,bob",
+					}),
+				),
+			},
+			{
+				// ReportPortal stores the options as the activity panel of its UI does
+				ResourceName:      "reportportal_widget.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccProjectScopedImportId("reportportal_widget.test"),
+				ImportStateVerify: true,
+			},
+			{
+				// the ReportPortal type of the widget type plans nothing
+				Config:   testAccResourceWidgetActivityConfig(fake, "activityStream"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceWidget_productStatus(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceWidgetProductStatusConfig(fake, "launch"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWidgetExists(fake, "reportportal_widget.test"),
+					resource.TestCheckResourceAttr("reportportal_widget.test", "widget_type_calculated", "productStatus"),
+					resource.TestCheckResourceAttr("reportportal_widget.test", "options_strategy", "launch"),
+					resource.TestCheckResourceAttr("reportportal_widget.test", "options_basic_columns.#", "2"),
+					resource.TestCheckResourceAttr("reportportal_widget.test", "options_basic_columns.1", "statistics$executions$failed"),
+					resource.TestCheckResourceAttr("reportportal_widget.test", "options_custom_columns.%", "1"),
+					resource.TestCheckResourceAttr("reportportal_widget.test", "options_custom_columns.Build", "build"),
+				),
+			},
+			{
+				Config: testAccResourceWidgetProductStatusConfig(fake, "filter"),
+				Check:  resource.TestCheckResourceAttr("reportportal_widget.test", "options_strategy", "filter"),
+			},
+			{
+				ResourceName:      "reportportal_widget.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccProjectScopedImportId("reportportal_widget.test"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceWidget_missingOptions(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceWidgetPassingRatePerLaunchConfig(fake),
+				ExpectError: regexp.MustCompile(`requires a launch name \(options_launch_name_filter\)`),
+			},
+			{
+				Config:      testAccResourceWidgetProductStatusConfig(fake, ""),
+				ExpectError: regexp.MustCompile(`requires a grouping strategy, launch or filter \(options_strategy\)`),
+			},
+		},
+	})
+}
+
+func TestAccResourceWidget_disappears(t *testing.T) {
+	fake := newFakeReportPortal(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceWidgetConfig(fake, "Overall", `["Total"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWidgetExists(fake, "reportportal_widget.test"),
+					func(s *terraform.State) error {
+						id, err := testAccResourceId(s, "reportportal_widget.test")
+						if err != nil {
+							return err
+						}
+						fake.removeWidget(id)
+						return nil
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccResourceWidgetConfig(fake, "Overall", `["Total"]`),
+				Check:  testAccCheckWidgetExists(fake, "reportportal_widget.test"),
+			},
+		},
+	})
+}
+
+func testAccCheckWidgetExists(fake *fakeReportPortal, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id, err := testAccResourceId(s, resourceName)
+		if err != nil {
+			return err
+		}
+		if !fake.hasWidget(id) {
+			return fmt.Errorf("widget %d not found", id)
+		}
+		return nil
+	}
+}
+
+func testAccCheckWidgetName(fake *fakeReportPortal, resourceName, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id, err := testAccResourceId(s, resourceName)
+		if err != nil {
+			return err
+		}
+		if got := fake.widgetName(id); got != name {
+			return fmt.Errorf("expected widget %d to be named %q, got %q", id, name, got)
+		}
+		return nil
+	}
+}
+
+func testAccCheckWidgetOptions(fake *fakeReportPortal, resourceName string, expected map[string]interface{}) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id, err := testAccResourceId(s, resourceName)
+		if err != nil {
+			return err
+		}
+		options := fake.widgetOptions(id)
+		for key, value := range expected {
+			if !reflect.DeepEqual(options[key], value) {
+				return fmt.Errorf("expected widget option %s to be %#v, got %#v", key, value, options[key])
+			}
+		}
+		return nil
+	}
+}
+
+func testAccResourceWidgetActivityConfig(fake *fakeReportPortal, widgetType string) string {
+	return testAccWidgetFilterConfig(fake) + fmt.Sprintf(`
+resource "reportportal_widget" "test" {
+  project_name           = reportportal_project.test.name
+  name                   = "Activity"
+  widget_type            = %q
+  filter_ids             = [reportportal_filter.test.id]
+  parameters_items_count = 50
+  options_action_type    = "startLaunch,finishLaunch"
+  options_user           = ["alice", "bob"]
+}
+`, widgetType)
+}
+
+func testAccResourceWidgetConfig(fake *fakeReportPortal, name, contentFields string) string {
+	return testAccWidgetFilterConfig(fake) + fmt.Sprintf(`
+resource "reportportal_widget" "test" {
+  project_name              = reportportal_project.test.name
+  name                      = %q
+  description               = "Overall statistics of the regression launches"
+  widget_type               = "Overall statistics"
+  filter_ids                = [reportportal_filter.test.id]
+  parameters_content_fields = %s
+  parameters_items_count    = 50
+  options_view_mode         = "panel"
+}
+`, name, contentFields)
+}
+
+func testAccResourceWidgetComponentHealthCheckConfig(fake *fakeReportPortal, minPassingRate int) string {
+	return testAccWidgetFilterConfig(fake) + fmt.Sprintf(`
+resource "reportportal_widget" "test" {
+  project_name             = reportportal_project.test.name
+  name                     = "Component health"
+  widget_type              = "Component health check (table view)"
+  filter_ids               = [reportportal_filter.test.id]
+  parameters_items_count   = 600
+  options_latest           = true
+  options_attribute_keys   = ["component", "platform"]
+  options_min_passing_rate = %d
+}
+`, minPassingRate)
+}
+
+func testAccResourceWidgetProductStatusConfig(fake *fakeReportPortal, strategy string) string {
+	strategyValue := "null"
+	if strategy != "" {
+		strategyValue = strconv.Quote(strategy)
+	}
+
+	return testAccWidgetFilterConfig(fake) + fmt.Sprintf(`
+resource "reportportal_widget" "test" {
+  project_name              = reportportal_project.test.name
+  name                      = "Product status"
+  widget_type               = "Product status"
+  filter_ids                = [reportportal_filter.test.id]
+  parameters_content_fields = ["Total", "Failed"]
+  options_strategy          = %s
+  options_basic_columns     = ["statistics$executions$total", "statistics$executions$failed"]
+  options_custom_columns = {
+    Build = "build"
+  }
+}
+`, strategyValue)
+}
+
+func testAccResourceWidgetPassingRatePerLaunchConfig(fake *fakeReportPortal) string {
+	return testAccWidgetFilterConfig(fake) + `
+resource "reportportal_widget" "test" {
+  project_name      = reportportal_project.test.name
+  name              = "Passing rate"
+  widget_type       = "Passing rate per launch"
+  options_view_mode = "bar"
+}
+`
+}
+
+func testAccWidgetFilterConfig(fake *fakeReportPortal) string {
+	return testAccProviderConfig(fake) + `
+resource "reportportal_project" "test" {
+  name = "tf_acc_widget"
+}
+
+resource "reportportal_filter" "test" {
+  project_name = reportportal_project.test.name
+  name         = "tf_acc_widget"
+  type         = "Launch"
+
+  orders {
+    sorting_column = "startTime"
+  }
+
+  conditions {
+    filtering_field = "name"
+    condition       = "cnt"
+    value           = "regression"
+  }
+}
+`
+}
@@ -0,0 +1,107 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type ActiveDirectoryIntegrationParameters struct {
+	Enabled  bool   `json:"-"`
+	Domain   string `json:"domain"`
+	Url      string `json:"url"`
+	BaseDn   string `json:"baseDn"`
+	Email    string `json:"email"`
+	FullName string `json:"fullName"`
+	Photo    string `json:"photo"`
+}
+
+type ActiveDirectoryIntegrationPayload struct {
+	Enabled               bool                                  `json:"enabled"`
+	IntegrationParameters *ActiveDirectoryIntegrationParameters `json:"integrationParameters"`
+	Name                  string                                `json:"name"`
+}
+
+type ActiveDirectorySettings struct {
+	Id             *int           `json:"id"`
+	LdapAttributes LdapAttributes `json:"ldapAttributes"`
+	Domain         *string        `json:"domain"`
+}
+
+func (c *Client) CreateAuthActiveDirectorySettings(config *ActiveDirectoryIntegrationParameters) (*ActiveDirectorySettings, error) {
+	payload := ActiveDirectoryIntegrationPayload{
+		Enabled:               config.Enabled,
+		IntegrationParameters: config,
+	}
+
+	return c.saveAuthActiveDirectorySettings("POST", fmt.Sprintf("%s/uat/settings/auth/ad", c.HostUrl), &payload)
+}
+
+func (c *Client) ReadActiveDirectoryAuthSettings() (*ActiveDirectorySettings, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/uat/settings/auth/ad", c.HostUrl), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp ActiveDirectorySettings
+	err = json.Unmarshal(body, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// UpdateAuthActiveDirectorySettings updates the Active Directory integration in place, which
+// like the LDAP one must be addressed by id since 5.4.0.
+func (c *Client) UpdateAuthActiveDirectorySettings(id *int, config *ActiveDirectoryIntegrationParameters) (*ActiveDirectorySettings, error) {
+	current, err := c.ReadActiveDirectoryAuthSettings()
+	if err != nil {
+		return nil, err
+	}
+	if current.Id == nil || *current.Id != *id {
+		return nil, &APIError{
+			StatusCode: http.StatusNotFound,
+			Message:    fmt.Sprintf("Auth integration '%d' not found", *id),
+		}
+	}
+
+	payload := ActiveDirectoryIntegrationPayload{
+		Enabled:               config.Enabled,
+		IntegrationParameters: config,
+	}
+
+	return c.saveAuthActiveDirectorySettings("PUT", fmt.Sprintf("%s/uat/settings/auth/ad/%d", c.HostUrl, *id), &payload)
+}
+
+func (c *Client) saveAuthActiveDirectorySettings(method, url string, payload *ActiveDirectoryIntegrationPayload) (*ActiveDirectorySettings, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(method, url, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp ActiveDirectorySettings
+	err = json.Unmarshal(body, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
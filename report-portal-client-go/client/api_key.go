@@ -0,0 +1,103 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type ApiKey struct {
+	Id     int    `json:"id"`
+	Name   string `json:"name"`
+	ApiKey string `json:"api_key,omitempty"`
+	UserId int    `json:"user_id,omitempty"`
+}
+
+type CreateApiKeyRequest struct {
+	Name string `json:"name"`
+}
+
+type GetApiKeysResponse struct {
+	Items []ApiKey `json:"items"`
+}
+
+// CreateApiKey generates a new key for the user, the secret is only returned by this call
+func (c *Client) CreateApiKey(userId int, name string) (*ApiKey, error) {
+	reqBody, err := json.Marshal(CreateApiKeyRequest{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/users/%d/api-keys", c.HostUrl, userId), bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Content-Type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp ApiKey
+	err = json.Unmarshal(body, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func (c *Client) GetApiKeys(userId int) (*GetApiKeysResponse, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/users/%d/api-keys", c.HostUrl, userId), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp GetApiKeysResponse
+	err = json.Unmarshal(body, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetApiKey looks the key up between the keys of the user, as there is no endpoint to read a single one
+func (c *Client) GetApiKey(userId int, keyId int) (*ApiKey, error) {
+	keys, err := c.GetApiKeys(userId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range keys.Items {
+		if key.Id == keyId {
+			return &key, nil
+		}
+	}
+
+	return nil, &APIError{
+		StatusCode: http.StatusNotFound,
+		Message:    fmt.Sprintf("API key '%d' not found for user '%d'", keyId, userId),
+	}
+}
+
+func (c *Client) DeleteApiKey(userId int, keyId int) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/api/users/%d/api-keys/%d", c.HostUrl, userId, keyId), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,408 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/google/go-querystring/query"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var PasswordEncryptionTypes = []string{"PLAIN", "SHA", "LDAP_SHA", "MD4", "MD5"}
+
+// tokenExpirationMargin renews the access token slightly before it actually expires,
+// so requests issued close to the expiration are not rejected in flight
+const tokenExpirationMargin = 30 * time.Second
+
+const (
+	defaultRetryWaitMin = 1 * time.Second
+	defaultRetryWaitMax = 30 * time.Second
+)
+
+type Client struct {
+	HostUrl    string
+	HTTPClient HttpClient
+	Token      string
+
+	// MaxRetries is the number of times a request failing with a transient error is sent again
+	MaxRetries   int
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
+
+	username        string
+	password        string
+	refreshToken    string
+	tokenExpiration time.Time
+	authMutex       sync.Mutex
+}
+
+type HttpClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+type ReportPortalClientConfig struct {
+	Username, Password, Host string
+	// ApiToken is used as the bearer token when provided, skipping the UI password grant
+	ApiToken string
+
+	// Retry policy applied to network errors, 429 and 5xx responses of replayable requests
+	MaxRetries                 int
+	RetryWaitMin, RetryWaitMax time.Duration
+}
+
+type uiAuthResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    uint   `json:"expires_in"`
+	Scope        string `json:"ui"`
+	Jti          string `json:"jti"`
+}
+
+func NewClient(config *ReportPortalClientConfig, httpClient HttpClient) (*Client, error) {
+	if len(config.Host) <= 0 {
+		return nil, fmt.Errorf("host is a required parameter")
+	}
+
+	if len(config.ApiToken) <= 0 && (len(config.Username) <= 0 || len(config.Password) <= 0) {
+		return nil, fmt.Errorf("either an api token or username and password are required parameters")
+	}
+
+	if httpClient == nil {
+		log.Print("ReportPortal client using the default HTTPClient: Timeout 10s")
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	c := Client{
+		HTTPClient:   httpClient,
+		HostUrl:      config.Host,
+		MaxRetries:   config.MaxRetries,
+		RetryWaitMin: config.RetryWaitMin,
+		RetryWaitMax: config.RetryWaitMax,
+	}
+
+	if len(config.ApiToken) > 0 {
+		c.Token = config.ApiToken
+		return &c, nil
+	}
+
+	c.username = config.Username
+	c.password = config.Password
+
+	err := c.authenticate()
+	if err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+// authenticate obtains a new access token through the UI password grant.
+// The caller must hold authMutex once the client is shared.
+func (c *Client) authenticate() error {
+	data := url.Values{}
+	data.Set("grant_type", "password")
+	data.Set("username", c.username)
+	data.Set("password", c.password)
+
+	return c.requestUiAccessToken(data)
+}
+
+// refreshAccessToken exchanges the stored refresh token for a new access token.
+// The caller must hold authMutex.
+func (c *Client) refreshAccessToken() error {
+	data := url.Values{}
+	data.Set("grant_type", "refresh_token")
+	data.Set("refresh_token", c.refreshToken)
+
+	return c.requestUiAccessToken(data)
+}
+
+func (c *Client) requestUiAccessToken(data url.Values) error {
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/uat/sso/oauth/token", c.HostUrl), strings.NewReader(data.Encode()))
+	if err != nil {
+		return err
+	}
+	req = withReplaySafe(req)
+
+	req.Header.Add("Authorization", "Basic dWk6dWltYW4=")
+	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+	body, err := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	ar := uiAuthResponse{}
+	err = json.Unmarshal(body, &ar)
+	if err != nil {
+		return err
+	}
+
+	c.Token = ar.AccessToken
+	c.refreshToken = ar.RefreshToken
+	c.tokenExpiration = time.Time{}
+	if ar.ExpiresIn > 0 {
+		c.tokenExpiration = time.Now().Add(time.Duration(ar.ExpiresIn) * time.Second)
+	}
+
+	return nil
+}
+
+// canReauthenticate reports whether the client holds credentials to obtain new tokens.
+// Clients configured with an API token keep using it as is.
+func (c *Client) canReauthenticate() bool {
+	return len(c.username) > 0 && len(c.password) > 0
+}
+
+// validToken returns the current access token, renewing it first when it is about to expire.
+func (c *Client) validToken() (string, error) {
+	c.authMutex.Lock()
+	defer c.authMutex.Unlock()
+
+	if c.canReauthenticate() && !c.tokenExpiration.IsZero() && time.Now().Add(tokenExpirationMargin).After(c.tokenExpiration) {
+		log.Print("[DEBUG] ReportPortal access token is about to expire, renewing it")
+		if err := c.renewToken(); err != nil {
+			return "", err
+		}
+	}
+
+	return c.Token, nil
+}
+
+// reauthenticate renews the access token after it was rejected by the server.
+// Nothing is done if another request already replaced the rejected token.
+func (c *Client) reauthenticate(rejectedToken string) (string, error) {
+	c.authMutex.Lock()
+	defer c.authMutex.Unlock()
+
+	if c.Token != rejectedToken {
+		return c.Token, nil
+	}
+
+	if err := c.renewToken(); err != nil {
+		return "", err
+	}
+
+	return c.Token, nil
+}
+
+// renewToken tries the refresh token first and falls back to the password grant.
+// The caller must hold authMutex.
+func (c *Client) renewToken() error {
+	if len(c.refreshToken) > 0 {
+		err := c.refreshAccessToken()
+		if err == nil {
+			return nil
+		}
+		log.Printf("[DEBUG] ReportPortal token refresh failed, authenticating again: %s", err)
+	}
+
+	return c.authenticate()
+}
+
+func (c *Client) doRequest(req *http.Request) ([]byte, error) {
+	if req.Header.Get("Authorization") != "" {
+		return c.sendRequest(req)
+	}
+
+	token, err := c.validToken()
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+	statusCode, body, err := c.send(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode == http.StatusUnauthorized && c.canReauthenticate() {
+		log.Printf("[DEBUG] ReportPortal rejected the access token for %s %s, authenticating again", req.Method, req.URL.Path)
+
+		token, err = c.reauthenticate(token)
+		if err != nil {
+			return nil, err
+		}
+
+		retry, err := rewindRequest(req)
+		if err != nil {
+			return nil, err
+		}
+		retry.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+		statusCode, body, err = c.send(retry)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return checkResponse(statusCode, body)
+}
+
+// sendRequest sends a request carrying its own Authorization header.
+func (c *Client) sendRequest(req *http.Request) ([]byte, error) {
+	statusCode, body, err := c.send(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return checkResponse(statusCode, body)
+}
+
+// send performs the request, sending it again with an exponential backoff while it
+// fails with a transient error and the request can be safely replayed.
+func (c *Client) send(req *http.Request) (int, []byte, error) {
+	for attempt := 0; ; attempt++ {
+		statusCode, header, body, err := c.sendOnce(req)
+		if attempt >= c.MaxRetries || !isRetryable(req, statusCode, err) {
+			return statusCode, body, err
+		}
+
+		wait := c.retryWait(attempt, header)
+		if err != nil {
+			log.Printf("[DEBUG] %s %s failed: %s. Retrying in %s", req.Method, req.URL.Path, err, wait)
+		} else {
+			log.Printf("[DEBUG] %s %s returned status %d. Retrying in %s", req.Method, req.URL.Path, statusCode, wait)
+		}
+
+		select {
+		case <-req.Context().Done():
+			return 0, nil, req.Context().Err()
+		case <-time.After(wait):
+		}
+
+		req, err = rewindRequest(req)
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+}
+
+func (c *Client) sendOnce(req *http.Request) (int, http.Header, []byte, error) {
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	return res.StatusCode, res.Header, body, nil
+}
+
+type replaySafeKey struct{}
+
+// withReplaySafe marks a request which is not idempotent by its method as safe to be
+// sent again after a transient failure.
+func withReplaySafe(req *http.Request) *http.Request {
+	return req.WithContext(context.WithValue(req.Context(), replaySafeKey{}, true))
+}
+
+func isReplayable(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	safe, _ := req.Context().Value(replaySafeKey{}).(bool)
+	return safe
+}
+
+func isRetryable(req *http.Request, statusCode int, err error) bool {
+	if !isReplayable(req) || req.Context().Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		return true
+	}
+
+	return statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+}
+
+// retryWait honours the Retry-After header sent by the server, falling back to an
+// exponential backoff bounded by RetryWaitMin and RetryWaitMax.
+func (c *Client) retryWait(attempt int, header http.Header) time.Duration {
+	waitMin, waitMax := c.RetryWaitMin, c.RetryWaitMax
+	if waitMin <= 0 {
+		waitMin = defaultRetryWaitMin
+	}
+	if waitMax < waitMin {
+		waitMax = defaultRetryWaitMax
+		if waitMax < waitMin {
+			waitMax = waitMin
+		}
+	}
+
+	if retryAfter := header.Get("Retry-After"); retryAfter != "" {
+		if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+			return minDuration(time.Duration(seconds)*time.Second, waitMax)
+		}
+		if date, err := http.ParseTime(retryAfter); err == nil {
+			return minDuration(time.Until(date), waitMax)
+		}
+	}
+
+	wait := waitMin
+	for i := 0; i < attempt && wait < waitMax; i++ {
+		wait *= 2
+	}
+
+	return minDuration(wait, waitMax)
+}
+
+func minDuration(a, b time.Duration) time.Duration {
+	if a < 0 {
+		return 0
+	}
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func checkResponse(statusCode int, body []byte) ([]byte, error) {
+	if statusCode < http.StatusOK || statusCode > http.StatusAlreadyReported {
+		return nil, newAPIError(statusCode, body)
+	}
+
+	return body, nil
+}
+
+// rewindRequest copies a request so it can be sent again, restoring its body.
+// Requests whose body can not be restored are not sent again, as the consumed body
+// would be sent empty.
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	retry := req.Clone(req.Context())
+	if req.Body != nil && req.Body != http.NoBody {
+		if req.GetBody == nil {
+			return nil, fmt.Errorf("unable to send %s %s again: the request body can not be rewound", req.Method, req.URL.Path)
+		}
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		retry.Body = body
+	}
+
+	return retry, nil
+}
+
+func parseQuery(i interface{}) string {
+	if i == nil {
+		return ""
+	}
+
+	v, _ := query.Values(i)
+	return v.Encode()
+}
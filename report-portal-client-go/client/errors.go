@@ -0,0 +1,85 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// APIError is returned for every ReportPortal response outside of the 2xx range
+type APIError struct {
+	StatusCode int
+	ErrorCode  int
+	Message    string
+	Body       string
+}
+
+type apiErrorResponse struct {
+	ErrorCode        int    `json:"errorCode"`
+	Message          string `json:"message"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+func newAPIError(statusCode int, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: statusCode,
+		Body:       string(body),
+	}
+
+	var resp apiErrorResponse
+	if err := json.Unmarshal(body, &resp); err == nil {
+		apiErr.ErrorCode = resp.ErrorCode
+		apiErr.Message = resp.Message
+		if apiErr.Message == "" {
+			// The authorization server answers with the OAuth2 error format
+			apiErr.Message = resp.ErrorDescription
+		}
+		if apiErr.Message == "" {
+			apiErr.Message = resp.Error
+		}
+	}
+
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("ReportPortal API error (status: %d): %s", e.StatusCode, e.Body)
+	}
+
+	if e.ErrorCode != 0 {
+		return fmt.Sprintf("ReportPortal API error (status: %d, errorCode: %d): %s", e.StatusCode, e.ErrorCode, e.Message)
+	}
+
+	return fmt.Sprintf("ReportPortal API error (status: %d): %s", e.StatusCode, e.Message)
+}
+
+// IsNotFound reports whether err is a ReportPortal 404 response
+func IsNotFound(err error) bool {
+	return hasStatusCode(err, http.StatusNotFound)
+}
+
+// IsConflict reports whether err is a ReportPortal 409 response
+func IsConflict(err error) bool {
+	return hasStatusCode(err, http.StatusConflict)
+}
+
+// IsUnauthorized reports whether err is a ReportPortal 401 response
+func IsUnauthorized(err error) bool {
+	return hasStatusCode(err, http.StatusUnauthorized)
+}
+
+// IsForbidden reports whether err is a ReportPortal 403 response
+func IsForbidden(err error) bool {
+	return hasStatusCode(err, http.StatusForbidden)
+}
+
+func hasStatusCode(err error, statusCode int) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == statusCode
+	}
+	return false
+}
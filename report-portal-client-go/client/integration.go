@@ -0,0 +1,136 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type IntegrationType struct {
+	Id        int    `json:"id"`
+	Name      string `json:"name"`
+	GroupType string `json:"groupType"`
+}
+
+type Integration struct {
+	Id                    int                    `json:"id"`
+	Name                  string                 `json:"name"`
+	Enabled               bool                   `json:"enabled"`
+	IntegrationParameters map[string]interface{} `json:"integrationParameters"`
+	IntegrationType       *IntegrationType       `json:"integrationType,omitempty"`
+}
+
+type IntegrationPayload struct {
+	Name                  string                 `json:"name"`
+	Enabled               bool                   `json:"enabled"`
+	IntegrationParameters map[string]interface{} `json:"integrationParameters"`
+}
+
+type CreateIntegrationResponse struct {
+	Id int `json:"id"`
+}
+
+// integrationUrl builds the URL of a plugin integration, which is global when no project is given
+func (c *Client) integrationUrl(projectName *string, path string) string {
+	if projectName == nil {
+		return fmt.Sprintf("%s/api/v1/integration/%s", c.HostUrl, path)
+	}
+	return fmt.Sprintf("%s/api/v1/integration/%s/%s", c.HostUrl, url.PathEscape(*projectName), path)
+}
+
+// DeleteIntegration removes an authentication integration, see DeleteIntegrationById for plugin integrations
+func (c *Client) DeleteIntegration(id *int) error {
+	request, err := http.NewRequest("DELETE", fmt.Sprintf("%s/uat/settings/auth/%d", c.HostUrl, *id), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(request)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) CreateIntegration(projectName *string, pluginName string, payload *IntegrationPayload) (*CreateIntegrationResponse, error) {
+	reqBody, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest("POST", c.integrationUrl(projectName, url.PathEscape(pluginName)), bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Content-Type", "application/json")
+
+	body, err := c.doRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	var response CreateIntegrationResponse
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func (c *Client) GetIntegration(projectName *string, id int) (*Integration, error) {
+	request, err := http.NewRequest("GET", c.integrationUrl(projectName, fmt.Sprintf("%d", id)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	var response Integration
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func (c *Client) UpdateIntegration(projectName *string, id int, payload *IntegrationPayload) error {
+	reqBody, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequest("PUT", c.integrationUrl(projectName, fmt.Sprintf("%d", id)), bytes.NewReader(reqBody))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Content-Type", "application/json")
+
+	_, err = c.doRequest(request)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) DeleteIntegrationById(projectName *string, id int) error {
+	request, err := http.NewRequest("DELETE", c.integrationUrl(projectName, fmt.Sprintf("%d", id)), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(request)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+var NotificationSendCases = []string{"ALWAYS", "FAILED", "TO_INVESTIGATE", "MORE_10", "MORE_20", "MORE_50"}
+
+var NotificationAttributesOperators = []string{"AND", "OR"}
+
+// NotificationRecipientOwner notifies the owner of the launch instead of a fixed e-mail address
+const NotificationRecipientOwner = "OWNER"
+
+type ItemAttribute struct {
+	Key   string `json:"key,omitempty"`
+	Value string `json:"value"`
+}
+
+type NotificationRule struct {
+	Id                 int             `json:"id,omitempty"`
+	RuleName           string          `json:"ruleName"`
+	Enabled            bool            `json:"enabled"`
+	SendCase           string          `json:"sendCase"`
+	Recipients         []string        `json:"recipients"`
+	LaunchNames        []string        `json:"launchNames"`
+	Attributes         []ItemAttribute `json:"attributes"`
+	AttributesOperator string          `json:"attributesOperator"`
+}
+
+type NotificationsConfiguration struct {
+	Cases []NotificationRule `json:"cases"`
+}
+
+type ProjectNotifications struct {
+	Enabled bool               `json:"enabled"`
+	Cases   []NotificationRule `json:"cases"`
+}
+
+// GetProjectNotifications reads the notification rules from the project configuration
+func (c *Client) GetProjectNotifications(projectName string) (*ProjectNotifications, error) {
+	project, err := c.GetProjectConfiguration(&projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	notifications := ProjectNotifications{
+		Cases: []NotificationRule{},
+	}
+	if enabled, ok := project.Configuration.Attributes[ProjectAttributeNotificationsEnabled]; ok {
+		notifications.Enabled, err = strconv.ParseBool(enabled)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if project.Configuration.NotificationsConfiguration != nil {
+		notifications.Cases = project.Configuration.NotificationsConfiguration.Cases
+	}
+
+	return &notifications, nil
+}
+
+// UpdateProjectNotifications replaces all the notification rules of the project
+func (c *Client) UpdateProjectNotifications(projectName string, notifications *ProjectNotifications) error {
+	reqBody, err := json.Marshal(notifications)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/api/v1/project/%s/notification", c.HostUrl, url.PathEscape(projectName)), bytes.NewReader(reqBody))
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Content-Type", "application/json")
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
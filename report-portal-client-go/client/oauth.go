@@ -0,0 +1,91 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	OAuthRegistrationGithub = "github"
+
+	// OAuthRestrictionOrganizations holds the comma separated organizations allowed to log in
+	OAuthRestrictionOrganizations = "organizations"
+)
+
+type OAuthRegistration struct {
+	Id           string            `json:"id,omitempty"`
+	ClientId     string            `json:"clientId"`
+	ClientSecret string            `json:"clientSecret,omitempty"`
+	Restrictions map[string]string `json:"restrictions,omitempty"`
+}
+
+// Organizations returns the organizations the login is restricted to
+func (r *OAuthRegistration) Organizations() []string {
+	organizations := make([]string, 0)
+	for _, organization := range strings.Split(r.Restrictions[OAuthRestrictionOrganizations], ",") {
+		if organization = strings.TrimSpace(organization); organization != "" {
+			organizations = append(organizations, organization)
+		}
+	}
+	return organizations
+}
+
+func (c *Client) GetOAuthSettings(registrationId string) (*OAuthRegistration, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/uat/settings/oauth/%s", c.HostUrl, registrationId), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp OAuthRegistration
+	err = json.Unmarshal(body, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// UpdateOAuthSettings creates or replaces the OAuth registration
+func (c *Client) UpdateOAuthSettings(registrationId string, registration *OAuthRegistration) (*OAuthRegistration, error) {
+	data, err := json.Marshal(registration)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/uat/settings/oauth/%s", c.HostUrl, registrationId), bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp OAuthRegistration
+	err = json.Unmarshal(body, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func (c *Client) DeleteOAuthSettings(registrationId string) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/uat/settings/oauth/%s", c.HostUrl, registrationId), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	return err
+}
@@ -0,0 +1,242 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type Project struct {
+	Id               int    `json:"id"`
+	ProjectName      string `json:"projectName"`
+	UsersQuantity    int    `json:"usersQuantity"`
+	LaunchesQuantity int    `json:"launchesQuantity"`
+	LastRun          int    `json:"lastRun"`
+	CreationDate     int    `json:"creationDate"`
+	EntryType        string `json:"entryType"`
+}
+
+// Project configuration attributes managed through UpdateProjectConfiguration
+const (
+	ProjectAttributeInterruptJobTime       = "job.interruptJobTime"
+	ProjectAttributeKeepLaunches           = "job.keepLaunches"
+	ProjectAttributeKeepLogs               = "job.keepLogs"
+	ProjectAttributeKeepScreenshots        = "job.keepScreenshots"
+	ProjectAttributeAutoAnalyzerEnabled    = "analyzer.isAutoAnalyzerEnabled"
+	ProjectAttributePatternAnalysisEnabled = "pattern.analysis.enabled"
+	ProjectAttributeNotificationsEnabled   = "notifications.enabled"
+
+	ProjectAttributeAnalyzerMode                   = "analyzer.autoAnalyzerMode"
+	ProjectAttributeAnalyzerMinShouldMatch         = "analyzer.minShouldMatch"
+	ProjectAttributeAnalyzerMinDocFreq             = "analyzer.minDocFreq"
+	ProjectAttributeAnalyzerNumberOfLogLines       = "analyzer.numberOfLogLines"
+	ProjectAttributeAnalyzerAllMessagesShouldMatch = "analyzer.allMessagesShouldMatch"
+	ProjectAttributeAnalyzerIndexingRunning        = "analyzer.indexingRunning"
+)
+
+var AnalyzerModes = []string{"ALL", "CURRENT_LAUNCH", "LAUNCH_NAME"}
+
+type ProjectConfiguration struct {
+	Attributes                 map[string]string           `json:"attributes"`
+	NotificationsConfiguration *NotificationsConfiguration `json:"notificationsConfiguration,omitempty"`
+}
+
+type ProjectResource struct {
+	ProjectId     int                  `json:"projectId"`
+	ProjectName   string               `json:"projectName"`
+	EntryType     string               `json:"entryType"`
+	Configuration ProjectConfiguration `json:"configuration"`
+}
+
+type UpdateProjectRequest struct {
+	Configuration *ProjectConfiguration `json:"configuration,omitempty"`
+	Users         map[string]string     `json:"users,omitempty"`
+}
+
+type GetAllProjectsResponse struct {
+	Content []Project `json:"content"`
+}
+
+type CreateProjectRequest struct {
+	EntryType   string `json:"entryType"`
+	ProjectName string `json:"projectName"`
+}
+
+type CreateProjectResponse struct {
+	Id int `json:"id"`
+}
+
+type LaunchPerUser struct {
+	Count    int    `json:"count"`
+	FullName string `json:"fullName"`
+}
+
+type GetProjectByNameResponse struct {
+	Project
+	LaunchesPerUser  []LaunchPerUser `json:"launchesPerUser"`
+	LaunchesPerWeek  string          `json:"launchesPerWeek"`
+	LaunchesQuantity int             `json:"launchesQuantity"`
+	Organization     string          `json:"organization"`
+	UniqueTickets    int             `json:"uniqueTickets"`
+	UsersQuantity    int             `json:"usersQuantity"`
+}
+
+func (c *Client) GetAllProjects() (*GetAllProjectsResponse, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/project/list", c.HostUrl), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp GetAllProjectsResponse
+	err = json.Unmarshal(body, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func (c *Client) CreateProject(projectName *string) (*CreateProjectResponse, error) {
+	request := CreateProjectRequest{
+		EntryType:   "INTERNAL",
+		ProjectName: *projectName,
+	}
+
+	reqBody, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/v1/project", c.HostUrl), bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Content-Type", "application/json")
+
+	respBody, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var jsonBody CreateProjectResponse
+	err = json.Unmarshal(respBody, &jsonBody)
+	if err != nil {
+		return nil, err
+	}
+
+	return &jsonBody, nil
+}
+
+func (c *Client) GetProjectByName(projectName *string) (*GetProjectByNameResponse, error) {
+	request, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/project/list/%s", c.HostUrl, url.PathEscape(*projectName)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	var response GetProjectByNameResponse
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+func (c *Client) DeleteProject(projectId *int) error {
+	request, err := http.NewRequest("DELETE", fmt.Sprintf("%s/api/v1/project/%d", c.HostUrl, *projectId), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(request)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) GetProjectConfiguration(projectName *string) (*ProjectResource, error) {
+	request, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/project/%s", c.HostUrl, url.PathEscape(*projectName)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	var response ProjectResource
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// UpdateProjectConfiguration changes the given configuration attributes, keeping the omitted ones
+func (c *Client) UpdateProjectConfiguration(projectName *string, attributes map[string]string) error {
+	reqBody, err := json.Marshal(UpdateProjectRequest{
+		Configuration: &ProjectConfiguration{Attributes: attributes},
+	})
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequest("PUT", fmt.Sprintf("%s/api/v1/project/%s", c.HostUrl, url.PathEscape(*projectName)), bytes.NewReader(reqBody))
+	if err != nil {
+		return err
+	}
+	request.Header.Add("Content-Type", "application/json")
+
+	_, err = c.doRequest(request)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GenerateProjectIndex starts rebuilding the analyzer index of the project from its launches
+func (c *Client) GenerateProjectIndex(projectName *string) error {
+	request, err := http.NewRequest("PUT", fmt.Sprintf("%s/api/v1/project/%s/index", c.HostUrl, url.PathEscape(*projectName)), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(request)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteProjectIndex removes the analyzer index of the project
+func (c *Client) DeleteProjectIndex(projectName *string) error {
+	request, err := http.NewRequest("DELETE", fmt.Sprintf("%s/api/v1/project/%s/index", c.HostUrl, url.PathEscape(*projectName)), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(request)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,251 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+var IssueTypeGroups = []string{"PRODUCT_BUG", "AUTOMATION_BUG", "SYSTEM_ISSUE", "NO_DEFECT", "TO_INVESTIGATE"}
+
+var PatternTypes = []string{"STRING", "REGEX"}
+
+type IssueSubType struct {
+	Id        int    `json:"id,omitempty"`
+	Locator   string `json:"locator,omitempty"`
+	TypeRef   string `json:"typeRef"`
+	LongName  string `json:"longName"`
+	ShortName string `json:"shortName"`
+	Color     string `json:"color"`
+}
+
+// ContentField is the widget criteria referencing the statistics of the sub-type
+func (s *IssueSubType) ContentField() string {
+	return fmt.Sprintf("statistics$defects$%s$%s", strings.ToLower(s.TypeRef), s.Locator)
+}
+
+type ProjectSettings struct {
+	Project  int                       `json:"project"`
+	SubTypes map[string][]IssueSubType `json:"subTypes"`
+	Patterns []PatternRule             `json:"patterns"`
+}
+
+type PatternRule struct {
+	Id      int    `json:"id,omitempty"`
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Value   string `json:"value"`
+	Enabled bool   `json:"enabled"`
+}
+
+type CreatePatternRuleResponse struct {
+	Id int `json:"id"`
+}
+
+// UpdatePatternRuleRequest only carries the mutable fields, the type and value of a pattern cannot change
+type UpdatePatternRuleRequest struct {
+	Name    string `json:"name"`
+	Enabled bool   `json:"enabled"`
+}
+
+type CreateIssueSubTypeResponse struct {
+	Id      int    `json:"id"`
+	Locator string `json:"locator"`
+}
+
+type UpdateIssueSubTypesRequest struct {
+	Ids []IssueSubType `json:"ids"`
+}
+
+func (c *Client) GetProjectSettings(projectName string) (*ProjectSettings, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/%s/settings", c.HostUrl, url.PathEscape(projectName)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp ProjectSettings
+	err = json.Unmarshal(body, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetIssueSubType looks the sub-type up in the project settings, as there is no endpoint to read a single one
+func (c *Client) GetIssueSubType(projectName string, subTypeId int) (*IssueSubType, error) {
+	settings, err := c.GetProjectSettings(projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, subTypes := range settings.SubTypes {
+		for _, subType := range subTypes {
+			if subType.Id == subTypeId {
+				return &subType, nil
+			}
+		}
+	}
+
+	return nil, &APIError{
+		StatusCode: http.StatusNotFound,
+		Message:    fmt.Sprintf("Issue sub-type '%d' not found on project '%s'", subTypeId, projectName),
+	}
+}
+
+func (c *Client) CreateIssueSubType(projectName string, subType *IssueSubType) (*CreateIssueSubTypeResponse, error) {
+	reqBody, err := json.Marshal(subType)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/v1/%s/settings/sub-type", c.HostUrl, url.PathEscape(projectName)), bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Content-Type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp CreateIssueSubTypeResponse
+	err = json.Unmarshal(body, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// UpdateIssueSubType updates the sub-type with the locator of the given one, ReportPortal
+// does not look sub-types up by id on update
+func (c *Client) UpdateIssueSubType(projectName string, subType *IssueSubType) error {
+	if subType.Locator == "" {
+		return fmt.Errorf("the locator of the issue sub-type is required to update it")
+	}
+
+	update := *subType
+	update.Id = 0
+	reqBody, err := json.Marshal(UpdateIssueSubTypesRequest{
+		Ids: []IssueSubType{update},
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/api/v1/%s/settings/sub-type", c.HostUrl, url.PathEscape(projectName)), bytes.NewReader(reqBody))
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Content-Type", "application/json")
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) DeleteIssueSubType(projectName string, subTypeId int) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/api/v1/%s/settings/sub-type/%d", c.HostUrl, url.PathEscape(projectName), subTypeId), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetPatternRule looks the pattern up in the project settings, as there is no endpoint to read a single one
+func (c *Client) GetPatternRule(projectName string, patternId int) (*PatternRule, error) {
+	settings, err := c.GetProjectSettings(projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pattern := range settings.Patterns {
+		if pattern.Id == patternId {
+			return &pattern, nil
+		}
+	}
+
+	return nil, &APIError{
+		StatusCode: http.StatusNotFound,
+		Message:    fmt.Sprintf("Pattern '%d' not found on project '%s'", patternId, projectName),
+	}
+}
+
+func (c *Client) CreatePatternRule(projectName string, pattern *PatternRule) (*CreatePatternRuleResponse, error) {
+	reqBody, err := json.Marshal(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/v1/%s/settings/pattern", c.HostUrl, url.PathEscape(projectName)), bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Content-Type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp CreatePatternRuleResponse
+	err = json.Unmarshal(body, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func (c *Client) UpdatePatternRule(projectName string, patternId int, pattern *UpdatePatternRuleRequest) error {
+	reqBody, err := json.Marshal(pattern)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/api/v1/%s/settings/pattern/%d", c.HostUrl, url.PathEscape(projectName), patternId), bytes.NewReader(reqBody))
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Content-Type", "application/json")
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) DeletePatternRule(projectName string, patternId int) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/api/v1/%s/settings/pattern/%d", c.HostUrl, url.PathEscape(projectName), patternId), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+var ProjectRoles = []string{"OPERATOR", "CUSTOMER", "MEMBER", "PROJECT_MANAGER"}
+
+type GetProjectUsersResponse struct {
+	Content []User             `json:"content"`
+	Page    PaginationResponse `json:"page"`
+}
+
+type AssignProjectUsersRequest struct {
+	UserNames map[string]string `json:"userNames"`
+}
+
+type UnassignProjectUsersRequest struct {
+	UserNames []string `json:"userNames"`
+}
+
+func (c *Client) GetProjectUsers(projectName string, pagination *PaginationQuery) (*GetProjectUsersResponse, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/project/%s/users", c.HostUrl, url.PathEscape(projectName)), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.URL.RawQuery = parseQuery(pagination)
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp GetProjectUsersResponse
+	err = json.Unmarshal(body, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetAllProjectUsers walks through every page of the project members
+func (c *Client) GetAllProjectUsers(projectName string) ([]User, error) {
+	users := make([]User, 0, 10)
+	currentPage := 1
+	defaultSize := 100
+	for {
+		resp, err := c.GetProjectUsers(projectName, &PaginationQuery{
+			Page: &currentPage,
+			Size: &defaultSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, resp.Content...)
+
+		if currentPage >= resp.Page.TotalPages {
+			break
+		}
+		currentPage++
+	}
+	return users, nil
+}
+
+// GetProjectUser looks the user up between the project members, as there is no endpoint to read a single one
+func (c *Client) GetProjectUser(projectName string, login string) (*User, error) {
+	users, err := c.GetAllProjectUsers(projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, user := range users {
+		if user.UserId == login {
+			return &user, nil
+		}
+	}
+
+	return nil, &APIError{
+		StatusCode: http.StatusNotFound,
+		Message:    fmt.Sprintf("User '%s' is not assigned to project '%s'", login, projectName),
+	}
+}
+
+// AssignProjectUsers grants the project roles, keyed by user login
+func (c *Client) AssignProjectUsers(projectName string, users map[string]string) error {
+	reqBody, err := json.Marshal(AssignProjectUsersRequest{UserNames: users})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/api/v1/project/%s/assign", c.HostUrl, url.PathEscape(projectName)), bytes.NewReader(reqBody))
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Content-Type", "application/json")
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) UnassignProjectUsers(projectName string, logins []string) error {
+	reqBody, err := json.Marshal(UnassignProjectUsersRequest{UserNames: logins})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/api/v1/project/%s/unassign", c.HostUrl, url.PathEscape(projectName)), bytes.NewReader(reqBody))
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Content-Type", "application/json")
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateProjectUserRoles changes the roles of users already assigned to the project, keyed by user login
+func (c *Client) UpdateProjectUserRoles(projectName string, users map[string]string) error {
+	reqBody, err := json.Marshal(UpdateProjectRequest{Users: users})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/api/v1/project/%s", c.HostUrl, url.PathEscape(projectName)), bytes.NewReader(reqBody))
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Content-Type", "application/json")
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
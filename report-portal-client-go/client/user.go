@@ -0,0 +1,193 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/google/go-querystring/query"
+	"net/http"
+	"net/url"
+)
+
+var AccountRoles = []string{"USER", "ADMINISTRATOR"}
+
+type AssignedProject struct {
+	ProjectRole string `json:"projectRole"`
+	EntryType   string `json:"entryType"`
+}
+
+type User struct {
+	Id               int                        `json:"id"`
+	UserId           string                     `json:"userId"`
+	Email            string                     `json:"email"`
+	FullName         string                     `json:"fullName"`
+	AccountType      string                     `json:"accountType"`
+	UserRole         string                     `json:"userRole"`
+	AssignedProjects map[string]AssignedProject `json:"assignedProjects"`
+}
+
+type CreateUserRequest struct {
+	Login          string `json:"login"`
+	Password       string `json:"password"`
+	FullName       string `json:"fullName"`
+	Email          string `json:"email"`
+	AccountRole    string `json:"accountRole"`
+	ProjectRole    string `json:"projectRole"`
+	DefaultProject string `json:"defaultProject"`
+}
+
+type CreateUserResponse struct {
+	Id    int    `json:"id"`
+	Login string `json:"login"`
+}
+
+type UpdateUserRequest struct {
+	Email    string `json:"email"`
+	FullName string `json:"fullName"`
+	Role     string `json:"role"`
+}
+
+// UserQuery searches users by the given login and email fragments
+type UserQuery struct {
+	Login *string `url:"filter.cnt.user,omitempty"`
+	Email *string `url:"filter.cnt.email,omitempty"`
+}
+
+type GetUsersResponse struct {
+	Content []User             `json:"content"`
+	Page    PaginationResponse `json:"page"`
+}
+
+func (c *Client) CreateUser(user *CreateUserRequest) (*CreateUserResponse, error) {
+	reqBody, err := json.Marshal(user)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/v1/user", c.HostUrl), bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Content-Type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp CreateUserResponse
+	err = json.Unmarshal(body, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func (c *Client) GetUser(login string) (*User, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/user/%s", c.HostUrl, url.PathEscape(login)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp User
+	err = json.Unmarshal(body, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetCurrentUser returns the user the client is authenticated as
+func (c *Client) GetCurrentUser() (*User, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/user", c.HostUrl), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp User
+	err = json.Unmarshal(body, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func (c *Client) GetUsers(userQuery *UserQuery, pagination *PaginationQuery) (*GetUsersResponse, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/user/all", c.HostUrl), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := query.Values(userQuery)
+	if err != nil {
+		return nil, err
+	}
+	paginationValues, err := query.Values(pagination)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range paginationValues {
+		values[k] = v
+	}
+	req.URL.RawQuery = values.Encode()
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp GetUsersResponse
+	err = json.Unmarshal(body, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+func (c *Client) UpdateUser(login string, user *UpdateUserRequest) error {
+	reqBody, err := json.Marshal(user)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/api/v1/user/%s", c.HostUrl, url.PathEscape(login)), bytes.NewReader(reqBody))
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Content-Type", "application/json")
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) DeleteUser(userId int) error {
+	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/api/v1/user/%d", c.HostUrl, userId), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package client
+
+var WidgetTypes = map[string]string{
+	"oldLineChart":                                   "oldLineChart", // legacy launch statistics line chart
+	"Launch statistics chart":                        "statisticTrend",
+	"Launch duration chart":                          "launchesDurationChart",
+	"Failed cases trend chart":                       "bugTrend",
+	"Overall statistics":                             "overallStatistics",
+	"Most failed test-cases table (TOP-20)":          "topTestCases",
+	"Flaky test cases table (TOP-20)":                "flakyTestCases",
+	"Test-cases growth trend chart":                  "casesTrend",
+	"Non-passed test-cases trend chart":              "notPassed",
+	"Investigated percentage of launches":            "investigatedTrend",
+	"Launch execution and issue statistic":           "launchStatistics",
+	"Unique bugs table":                              "uniqueBugTable",
+	"Project activity panel":                         "activityStream",
+	"Different launches comparison chart":            "launchesComparisonChart",
+	"Launches table":                                 "launchesTable",
+	"Passing rate summary":                           "passingRateSummary",
+	"Passing rate per launch":                        "passingRatePerLaunch",
+	"Product status":                                 "productStatus",
+	"Most time-consuming test cases widget (TOP-20)": "mostTimeConsuming",
+	"Cumulative trend chart":                         "cumulative",
+	"Component health check":                         "componentHealthCheck",
+	"Most popular pattern table (TOP-20)":            "topPatternTemplates",
+	"Component health check (table view)":            "componentHealthCheckTable",
+}
+var WidgetCriteria = map[string]string{
+	"Total":                "statistics$executions$total",
+	"Passed":               "statistics$executions$passed",
+	"Failed":               "statistics$executions$failed",
+	"Skipped":              "statistics$executions$skipped",
+	"Product Bug":          "statistics$defects$product_bug$pb001",
+	"Automation Bug":       "statistics$defects$automation_bug$ab001",
+	"System Issue":         "statistics$defects$system_issue$si001",
+	"No Defect":            "statistics$defects$no_defect$nd001",
+	"To Investigate":       "statistics$defects$to_investigate$ti001",
+	"Product Bug Total":    "statistics$defects$product_bug$total",
+	"Automation Bug Total": "statistics$defects$automation_bug$total",
+	"System Issue Total":   "statistics$defects$system_issue$total",
+	"No Defect Total":      "statistics$defects$no_defect$total",
+	"To Investigate Total": "statistics$defects$to_investigate$total",
+	"Start time":           "startTime",
+	"End Time":             "endTime",
+	"Name":                 "name",
+	"Number":               "number",
+	"Status":               "status",
+}
+var WidgetVisualizationOptions = map[string]string{
+	"Area": "area-spline",
+	"Bars": "bars",
+}
+var WidgetModes = map[string]string{
+	"Launch":   "launch",
+	"timeline": "day",
+}
@@ -0,0 +1,5 @@
+module github.com/rmalveis/report-portal-client-go
+
+go 1.16
+
+require github.com/google/go-querystring v1.1.0